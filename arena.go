@@ -0,0 +1,57 @@
+package list
+
+// Arena is an element allocator that several lists can share. Elements
+// are allocated in blocks, and elements removed from any list using the
+// arena are recycled through a shared free list, so moving values between
+// lists does not allocate once the arena has grown to its working size.
+//
+// Memory held by an arena is never released while the arena is
+// reachable. An Arena is not safe for concurrent use, so lists sharing an
+// arena must not be used concurrently.
+type Arena[E any] struct {
+	free      *Element[E] // free elements, chained through next
+	blockSize int
+}
+
+// NewArena returns an arena that allocates blockSize elements at a time.
+// If blockSize is less than 1, a default of 64 is used.
+func NewArena[E any](blockSize int) *Arena[E] {
+	if blockSize < 1 {
+		blockSize = 64
+	}
+	return &Arena[E]{blockSize: blockSize}
+}
+
+// NewWithArena returns an initialized list that allocates its elements
+// from a and recycles removed elements into it instead of its own pool.
+// Lists derived from it, for example by SplitBefore, do not use the arena.
+func NewWithArena[E any](a *Arena[E]) *List[E] {
+	l := New[E]()
+	l.alloc = a
+	return l
+}
+
+// allocator is a source of elements shared between lists.
+type allocator[E any] interface {
+	get() *Element[E]
+	put(e *Element[E])
+}
+
+func (a *Arena[E]) get() *Element[E] {
+	if a.free == nil {
+		block := make([]Element[E], a.blockSize)
+		for i := range block[:len(block)-1] {
+			block[i].next = &block[i+1]
+		}
+		a.free = &block[0]
+	}
+	e := a.free
+	a.free = e.next
+	e.next = nil
+	return e
+}
+
+func (a *Arena[E]) put(e *Element[E]) {
+	e.next = a.free
+	a.free = e
+}
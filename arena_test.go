@@ -0,0 +1,44 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestArena(t *testing.T) {
+	a := NewArena[int](8)
+	l1, l2 := NewWithArena(a), NewWithArena(a)
+	for i := range 8 {
+		l1.PushBack(i)
+	}
+	if a.free != nil {
+		t.Errorf("arena has free elements after using a whole block")
+	}
+
+	// Moving values between the lists recycles elements through the arena.
+	move := func() {
+		for {
+			v, ok := l1.PopFront()
+			if !ok {
+				break
+			}
+			l2.PushBack(v)
+		}
+		l1, l2 = l2, l1
+	}
+	if n := testing.AllocsPerRun(10, move); n != 0 {
+		t.Errorf("moving values between arena lists allocated %v times", n)
+	}
+	if got, want := slices.Collect(l1.All()), []int{0, 1, 2, 3, 4, 5, 6, 7}; !slices.Equal(got, want) {
+		t.Errorf("l1.All() = %v, want %v", got, want)
+	}
+	l1.PushBackSlice([]int{8, 9})
+	if l1.Len() != 10 || l2.Len() != 0 {
+		t.Errorf("Len = %d, %d; want 10, 0", l1.Len(), l2.Len())
+	}
+	for _, l := range []*List[int]{l1, l2} {
+		if err := l.CheckInvariants(); err != nil {
+			t.Error(err)
+		}
+	}
+}
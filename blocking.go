@@ -0,0 +1,96 @@
+package list
+
+import (
+	"context"
+	"sync"
+)
+
+// BlockingList is a double-ended queue, safe for concurrent use, whose
+// Wait methods block until a value is available or a context is done.
+// It is intended for producer/consumer pipelines.
+// A BlockingList must be created with NewBlockingList.
+type BlockingList[E any] struct {
+	mu    sync.Mutex
+	ready sync.Cond // signalled when a value is pushed
+	list  List[E]
+}
+
+// NewBlockingList returns an empty blocking list.
+func NewBlockingList[E any]() *BlockingList[E] {
+	b := &BlockingList[E]{}
+	b.ready.L = &b.mu
+	return b
+}
+
+// Len returns the number of values in b.
+func (b *BlockingList[E]) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.list.Len()
+}
+
+// PushFront inserts v at the front of b, waking one waiting consumer.
+func (b *BlockingList[E]) PushFront(v E) {
+	b.mu.Lock()
+	b.list.PushFront(v)
+	b.mu.Unlock()
+	b.ready.Signal()
+}
+
+// PushBack inserts v at the back of b, waking one waiting consumer.
+func (b *BlockingList[E]) PushBack(v E) {
+	b.mu.Lock()
+	b.list.PushBack(v)
+	b.mu.Unlock()
+	b.ready.Signal()
+}
+
+// PopFront removes and returns the front value of b without blocking.
+// If b is empty, PopFront returns the zero value and false.
+func (b *BlockingList[E]) PopFront() (E, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.list.PopFront()
+}
+
+// PopBack removes and returns the back value of b without blocking.
+// If b is empty, PopBack returns the zero value and false.
+func (b *BlockingList[E]) PopBack() (E, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.list.PopBack()
+}
+
+// PopFrontWait removes and returns the front value of b, blocking until one
+// is available. If ctx is done first, it returns the zero value and ctx.Err().
+func (b *BlockingList[E]) PopFrontWait(ctx context.Context) (E, error) {
+	return b.popWait(ctx, (*List[E]).PopFront)
+}
+
+// PopBackWait removes and returns the back value of b, blocking until one
+// is available. If ctx is done first, it returns the zero value and ctx.Err().
+func (b *BlockingList[E]) PopBackWait(ctx context.Context) (E, error) {
+	return b.popWait(ctx, (*List[E]).PopBack)
+}
+
+func (b *BlockingList[E]) popWait(ctx context.Context, pop func(*List[E]) (E, bool)) (E, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	// Wake the waiters when ctx is done so they can notice; those whose
+	// context is still live go back to waiting.
+	stop := context.AfterFunc(ctx, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.ready.Broadcast()
+	})
+	defer stop()
+	for b.list.Len() == 0 {
+		if err := ctx.Err(); err != nil {
+			var zero E
+			return zero, err
+		}
+		b.ready.Wait()
+	}
+	v, _ := pop(&b.list)
+	return v, nil
+}
@@ -0,0 +1,88 @@
+package list
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBlockingList(t *testing.T) {
+	b := NewBlockingList[int]()
+	b.PushBack(1)
+	b.PushBack(2)
+	b.PushFront(0)
+	ctx := context.Background()
+	if v, err := b.PopFrontWait(ctx); err != nil || v != 0 {
+		t.Errorf("PopFrontWait = %d, %v; want 0, nil", v, err)
+	}
+	if v, err := b.PopBackWait(ctx); err != nil || v != 2 {
+		t.Errorf("PopBackWait = %d, %v; want 2, nil", v, err)
+	}
+	if v, ok := b.PopFront(); !ok || v != 1 {
+		t.Errorf("PopFront = %d, %v; want 1, true", v, ok)
+	}
+	if _, ok := b.PopBack(); ok {
+		t.Errorf("PopBack on empty list succeeded")
+	}
+}
+
+func TestBlockingListWait(t *testing.T) {
+	b := NewBlockingList[int]()
+	const n = 1000
+	var wg sync.WaitGroup
+	results := make(chan int, n)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				v, err := b.PopFrontWait(context.Background())
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if v < 0 {
+					return
+				}
+				results <- v
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		b.PushBack(i)
+	}
+	for i := 0; i < 4; i++ {
+		b.PushBack(-1)
+	}
+	wg.Wait()
+	close(results)
+	sum := 0
+	for v := range results {
+		sum += v
+	}
+	if want := n * (n - 1) / 2; sum != want {
+		t.Errorf("consumed sum %d, want %d", sum, want)
+	}
+}
+
+func TestBlockingListCancel(t *testing.T) {
+	b := NewBlockingList[int]()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := b.PopBackWait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("PopBackWait error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// A value pushed after a cancelled wait is still delivered to a live waiter.
+	done := make(chan int)
+	go func() {
+		v, _ := b.PopFrontWait(context.Background())
+		done <- v
+	}()
+	b.PushBack(7)
+	if v := <-done; v != 7 {
+		t.Errorf("PopFrontWait = %d, want 7", v)
+	}
+}
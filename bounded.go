@@ -0,0 +1,74 @@
+package list
+
+import "iter"
+
+// Bounded is a list holding at most a fixed number of values. Pushing onto
+// a full Bounded first removes the oldest value, at the front, and reports
+// it to an optional eviction callback, which makes it suited to
+// recent-history buffers.
+//
+// Elements returned by a Bounded belong to an internal List. Callers may
+// traverse them with Next and Prev and modify their Value but must not
+// move them.
+type Bounded[E any] struct {
+	list    List[E]
+	max     int
+	onEvict func(E)
+	metrics Metrics
+}
+
+// NewBounded returns an empty list holding at most max values. If onEvict
+// is not nil, it is called with each value evicted to make room for a new
+// one. NewBounded panics if max is not positive.
+func NewBounded[E any](max int, onEvict func(E)) *Bounded[E] {
+	if max <= 0 {
+		panic("list: bounded capacity must be positive")
+	}
+	b := &Bounded[E]{max: max, onEvict: onEvict}
+	b.list.Init()
+	return b
+}
+
+// Len returns the number of values in b.
+func (b *Bounded[E]) Len() int { return b.list.Len() }
+
+// Cap returns the maximum number of values b holds.
+func (b *Bounded[E]) Cap() int { return b.max }
+
+// Front returns the oldest element of b or nil if b is empty.
+func (b *Bounded[E]) Front() *Element[E] { return b.list.Front() }
+
+// Back returns the newest element of b or nil if b is empty.
+func (b *Bounded[E]) Back() *Element[E] { return b.list.Back() }
+
+// PushBack inserts a new element with value v at the back of b and
+// returns it. If b is full, the front element is evicted first.
+func (b *Bounded[E]) PushBack(v E) *Element[E] {
+	if b.list.Len() >= b.max {
+		old := b.list.Remove(b.list.Front())
+		if b.metrics != nil {
+			b.metrics.AddEvictions(1)
+		}
+		if b.onEvict != nil {
+			b.onEvict(old)
+		}
+	}
+	return b.list.PushBack(v)
+}
+
+// SetMetrics makes b report its operations and evictions to m, or stops
+// reporting if m is nil.
+func (b *Bounded[E]) SetMetrics(m Metrics) {
+	b.metrics = m
+	b.list.SetMetrics(m)
+}
+
+// Remove removes e from b if e is an element of b and returns e.Value.
+// The eviction callback is not called. The element must not be nil.
+func (b *Bounded[E]) Remove(e *Element[E]) E { return b.list.Remove(e) }
+
+// All returns an iterator over the values of b from oldest to newest.
+func (b *Bounded[E]) All() iter.Seq[E] { return b.list.All() }
+
+// Backward returns an iterator over the values of b from newest to oldest.
+func (b *Bounded[E]) Backward() iter.Seq[E] { return b.list.Backward() }
@@ -0,0 +1,40 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBounded(t *testing.T) {
+	var evicted []int
+	b := NewBounded(3, func(v int) { evicted = append(evicted, v) })
+	if b.Cap() != 3 {
+		t.Errorf("b.Cap() = %d, want 3", b.Cap())
+	}
+	for i := range 5 {
+		b.PushBack(i)
+	}
+	if got, want := slices.Collect(b.All()), []int{2, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("b.All() = %v, want %v", got, want)
+	}
+	if want := []int{0, 1}; !slices.Equal(evicted, want) {
+		t.Errorf("evicted %v, want %v", evicted, want)
+	}
+	if b.Front().Value != 2 || b.Back().Value != 4 {
+		t.Errorf("Front, Back = %d, %d; want 2, 4", b.Front().Value, b.Back().Value)
+	}
+
+	// Removing makes room without an eviction.
+	if v := b.Remove(b.Front().Next()); v != 3 {
+		t.Errorf("b.Remove = %d, want 3", v)
+	}
+	b.PushBack(5)
+	if len(evicted) != 2 || b.Len() != 3 {
+		t.Errorf("unexpected eviction, evicted %v, len %d", evicted, b.Len())
+	}
+	if got, want := slices.Collect(b.Backward()), []int{5, 4, 2}; !slices.Equal(got, want) {
+		t.Errorf("b.Backward() = %v, want %v", got, want)
+	}
+
+	mustPanic(t, "NewBounded(0)", func() { NewBounded[int](0, nil) })
+}
@@ -0,0 +1,43 @@
+package list
+
+import "context"
+
+// ToChan returns a channel that receives the values of list l from front to
+// back, removing each value from l once it has been received. The channel is
+// closed when l is empty or ctx is done; values not yet received when ctx is
+// done remain in l. List l must not be used by other goroutines until the
+// channel is closed.
+func (l *List[E]) ToChan(ctx context.Context) <-chan E {
+	ch := make(chan E)
+	go func() {
+		defer close(ch)
+		for e := l.Front(); e != nil; e = l.Front() {
+			select {
+			case ch <- e.Value:
+				l.remove(e)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// AppendFromChan receives values from ch and inserts them at the back of
+// list l until ch is closed, in which case it returns nil, or ctx is done,
+// in which case it returns ctx.Err(). Values received before ctx is done
+// remain in l.
+func (l *List[E]) AppendFromChan(ctx context.Context, ch <-chan E) error {
+	l.lazyInit()
+	for {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			l.insertValue(v, l.root.prev)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package list
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestToChan(t *testing.T) {
+	l := NewFromSlice([]int{1, 2, 3})
+	var got []int
+	for v := range l.ToChan(context.Background()) {
+		got = append(got, v)
+	}
+	if want := []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("received %v, want %v", got, want)
+	}
+	checkListLen(t, l, 0)
+
+	l = NewFromSlice([]int{1, 2, 3})
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := l.ToChan(ctx)
+	if v := <-ch; v != 1 {
+		t.Errorf("first value = %d, want 1", v)
+	}
+	cancel()
+	for range ch {
+	}
+	if n := l.Len(); n < 1 || n > 2 {
+		t.Errorf("after cancel l.Len() = %d, want unreceived values to remain", n)
+	}
+}
+
+func TestAppendFromChan(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+	var l List[int]
+	if err := l.AppendFromChan(context.Background(), ch); err != nil {
+		t.Fatalf("AppendFromChan: %v", err)
+	}
+	if got, want := l.ToSlice(), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("l = %v, want %v", got, want)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.AppendFromChan(ctx, make(chan int)); !errors.Is(err, context.Canceled) {
+		t.Errorf("AppendFromChan error = %v, want %v", err, context.Canceled)
+	}
+}
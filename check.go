@@ -0,0 +1,57 @@
+package list
+
+import "fmt"
+
+// CheckInvariants walks list l and verifies its internal structure: that
+// next and prev links are symmetric, that every element is owned by l, that
+// the ring closes at the sentinel, that the element count matches Len, and
+// that pooled elements are detached. It returns an error describing the
+// first inconsistency found, or nil if l is intact.
+//
+// A failing check indicates memory corruption, a data race, or use of an
+// element after it was removed.
+func (l *List[E]) CheckInvariants() error {
+	root := &l.root
+	if root.next == nil || root.prev == nil {
+		if root.next != root.prev {
+			return fmt.Errorf("list: sentinel half initialized: next = %p, prev = %p", root.next, root.prev)
+		}
+		if l.len != 0 {
+			return fmt.Errorf("list: uninitialized list has length %d", l.len)
+		}
+		return l.checkPool()
+	}
+	n := 0
+	for e := root; ; e = e.next {
+		if e.next == nil {
+			return fmt.Errorf("list: element %d (%p) has nil next", n, e)
+		}
+		if e.next.prev != e {
+			return fmt.Errorf("list: element %d (%p): next.prev = %p, want %p", n, e, e.next.prev, e)
+		}
+		if e.next == root {
+			break
+		}
+		n++
+		if n > l.len {
+			return fmt.Errorf("list: more than %d elements reachable from front", l.len)
+		}
+		if e.next.list != l {
+			return fmt.Errorf("list: element %d (%p) belongs to list %p, not %p", n-1, e.next, e.next.list, l)
+		}
+	}
+	if n != l.len {
+		return fmt.Errorf("list: counted %d elements, Len is %d", n, l.len)
+	}
+	return l.checkPool()
+}
+
+// checkPool verifies that pooled elements are detached from any list.
+func (l *List[E]) checkPool() error {
+	for i, e := range l.epool {
+		if e.list != nil || e.next != nil || e.prev != nil {
+			return fmt.Errorf("list: pooled element %d (%p) is still linked", i, e)
+		}
+	}
+	return nil
+}
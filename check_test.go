@@ -0,0 +1,41 @@
+package list
+
+import "testing"
+
+func TestCheckInvariants(t *testing.T) {
+	var z List[int]
+	if err := z.CheckInvariants(); err != nil {
+		t.Errorf("zero list: %v", err)
+	}
+
+	l := New[int]()
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+	e3 := l.PushBack(3)
+	l.Remove(l.PushBack(4))
+	if err := l.CheckInvariants(); err != nil {
+		t.Errorf("valid list: %v", err)
+	}
+
+	corruptions := []struct {
+		name          string
+		corrupt, undo func()
+	}{
+		{"asymmetric link", func() { e2.prev = e3 }, func() { e2.prev = e1 }},
+		{"wrong owner", func() { e2.list = nil }, func() { e2.list = l }},
+		{"wrong length", func() { l.len++ }, func() { l.len-- }},
+		{"short length", func() { l.len-- }, func() { l.len++ }},
+		{"broken ring", func() { e3.next = nil }, func() { e3.next = &l.root }},
+		{"linked pool element", func() { l.epool[0].list = l }, func() { l.epool[0].list = nil }},
+	}
+	for _, c := range corruptions {
+		c.corrupt()
+		if err := l.CheckInvariants(); err == nil {
+			t.Errorf("%s: CheckInvariants returned nil", c.name)
+		}
+		c.undo()
+	}
+	if err := l.CheckInvariants(); err != nil {
+		t.Errorf("restored list: %v", err)
+	}
+}
@@ -0,0 +1,123 @@
+package list
+
+import "iter"
+
+// ClockList is a ring of elements with a reference bit per element and a
+// clock hand, as used by CLOCK (second-chance) page replacement. Tick
+// advances the hand, giving each referenced element a second chance by
+// clearing its bit, and returns the first unreferenced element as the
+// eviction victim.
+type ClockList[E any] struct {
+	list List[ClockElement[E]]
+	hand *Element[ClockElement[E]]
+}
+
+// ClockElement is an element of a ClockList. Callers may modify its Value.
+// Like a list Element, it must not be used after it is removed.
+type ClockElement[E any] struct {
+	// The value stored with this element.
+	Value E
+
+	ref  bool
+	elem *Element[ClockElement[E]] // the list element holding this one
+}
+
+// NewClockList returns an empty clock list.
+func NewClockList[E any]() *ClockList[E] {
+	c := new(ClockList[E])
+	c.list.Init()
+	return c
+}
+
+// Len returns the number of elements of clock list c.
+func (c *ClockList[E]) Len() int { return c.list.Len() }
+
+// Insert inserts a new unreferenced element with value v just behind the
+// hand, so that it is the last element the hand reaches, and returns it.
+func (c *ClockList[E]) Insert(v E) *ClockElement[E] {
+	var e *Element[ClockElement[E]]
+	if c.hand == nil {
+		e = c.list.PushBack(ClockElement[E]{Value: v})
+	} else {
+		e = c.list.InsertBefore(ClockElement[E]{Value: v}, c.hand)
+	}
+	e.Value.elem = e
+	return &e.Value
+}
+
+// Reference sets the reference bit of e, which must be an element of c.
+func (c *ClockList[E]) Reference(e *ClockElement[E]) {
+	if e.elem != nil && e.elem.list == &c.list {
+		e.ref = true
+	}
+}
+
+// Referenced reports whether the reference bit of e is set.
+func (c *ClockList[E]) Referenced(e *ClockElement[E]) bool { return e.ref }
+
+// Remove removes e from c if e is an element of c and returns e.Value.
+// If the hand points at e it moves on to the next element.
+// The element must not be nil.
+func (c *ClockList[E]) Remove(e *ClockElement[E]) E {
+	le := e.elem
+	if le == nil || le.list != &c.list {
+		return e.Value
+	}
+	if le == c.hand {
+		c.advance()
+		if c.hand == le {
+			c.hand = nil
+		}
+	}
+	return c.list.Remove(le).Value
+}
+
+// Tick sweeps the hand around c, clearing the reference bit of each
+// referenced element it passes, and returns the first unreferenced element.
+// The hand is left pointing past the returned element, which stays in c;
+// the caller may Remove it or reuse it in place. Tick returns nil if c is
+// empty.
+func (c *ClockList[E]) Tick() *ClockElement[E] {
+	if c.list.Len() == 0 {
+		return nil
+	}
+	if c.hand == nil {
+		c.hand = c.list.Front()
+	}
+	for {
+		e := &c.hand.Value
+		c.advance()
+		if !e.ref {
+			return e
+		}
+		e.ref = false
+	}
+}
+
+// advance moves the hand to the next element of the ring.
+func (c *ClockList[E]) advance() {
+	if c.hand = c.hand.Next(); c.hand == nil {
+		c.hand = c.list.Front()
+	}
+}
+
+// All returns an iterator over the values of c in ring order, starting at
+// the hand.
+func (c *ClockList[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		start := c.hand
+		if start == nil {
+			start = c.list.Front()
+		}
+		for e := start; e != nil; e = e.Next() {
+			if !yield(e.Value.Value) {
+				return
+			}
+		}
+		for e := c.list.Front(); e != start; e = e.Next() {
+			if !yield(e.Value.Value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestClockList(t *testing.T) {
+	c := NewClockList[int]()
+	if e := c.Tick(); e != nil {
+		t.Errorf("Tick on empty list = %v, want nil", e.Value)
+	}
+	var es []*ClockElement[int]
+	for i := range 4 {
+		es = append(es, c.Insert(i))
+	}
+	c.Reference(es[0])
+	c.Reference(es[2])
+	if !c.Referenced(es[0]) || c.Referenced(es[1]) {
+		t.Errorf("Referenced bits wrong")
+	}
+
+	// 0 gets a second chance, 1 is the victim.
+	if e := c.Tick(); e != es[1] {
+		t.Errorf("c.Tick() = %d, want 1", e.Value)
+	}
+	if c.Referenced(es[0]) {
+		t.Errorf("bit of 0 not cleared by Tick")
+	}
+	if got, want := slices.Collect(c.All()), []int{2, 3, 0, 1}; !slices.Equal(got, want) {
+		t.Errorf("c.All() = %v, want %v", got, want)
+	}
+
+	// A new element goes behind the hand, after everything else.
+	c.Remove(es[1])
+	es[1] = c.Insert(4)
+	if got, want := slices.Collect(c.All()), []int{2, 3, 0, 4}; !slices.Equal(got, want) {
+		t.Errorf("c.All() after Insert = %v, want %v", got, want)
+	}
+	if e := c.Tick(); e != es[3] {
+		t.Errorf("c.Tick() = %d, want 3", e.Value)
+	}
+	if e := c.Tick(); e != es[0] {
+		t.Errorf("c.Tick() = %d, want 0", e.Value)
+	}
+
+	// Removing the element under the hand moves the hand on.
+	c.Remove(es[1])
+	if got, want := slices.Collect(c.All()), []int{2, 3, 0}; !slices.Equal(got, want) {
+		t.Errorf("c.All() after Remove = %v, want %v", got, want)
+	}
+	for range 3 {
+		c.Remove(c.Tick())
+	}
+	if c.Len() != 0 || c.Tick() != nil {
+		t.Errorf("c not empty after removing every victim")
+	}
+	if err := c.list.CheckInvariants(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,61 @@
+package list
+
+// Cursor finds elements of a list by index, remembering the last element
+// it found so that nearby indices are reached without walking from an end
+// of the list. Scanning a list by successive indices through a cursor is
+// therefore linear rather than quadratic. The remembered position is
+// discarded whenever the list is structurally modified.
+//
+// A Cursor is not safe for concurrent use, but any number of cursors may
+// read the same list concurrently as long as it is not modified.
+type Cursor[E any] struct {
+	l   *List[E]
+	e   *Element[E] // element most recently found, valid while mod == l.mod
+	i   int         // index of e
+	mod uint64
+}
+
+// Cursor returns a new cursor over list l.
+func (l *List[E]) Cursor() *Cursor[E] { return &Cursor[E]{l: l} }
+
+// At returns the element at position i of the list, or nil if i is out of
+// range. A negative i counts from the back. The list is walked from
+// whichever is closest to i: the front, the back, or the element found by
+// the previous call to At or IndexOf.
+func (c *Cursor[E]) At(i int) *Element[E] {
+	l := c.l
+	if i < 0 {
+		i += l.len
+	}
+	if i < 0 || i >= l.len {
+		return nil
+	}
+	e, at := l.root.next, 0
+	if back := l.len - 1; back-i < i {
+		e, at = l.root.prev, back
+	}
+	if c.e != nil && c.mod == l.mod && abs(c.i-i) < abs(at-i) {
+		e, at = c.e, c.i
+	}
+	e = walk(e, at, i)
+	c.e, c.i, c.mod = e, i, l.mod
+	return e
+}
+
+// IndexOf returns the zero-based position of e in the list, or -1 if e is
+// not an element of it, and remembers e for later calls to At. The element
+// must not be nil.
+func (c *Cursor[E]) IndexOf(e *Element[E]) int {
+	i := c.l.IndexOf(e)
+	if i >= 0 {
+		c.e, c.i, c.mod = e, i, c.l.mod
+	}
+	return i
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
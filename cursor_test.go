@@ -0,0 +1,82 @@
+package list
+
+import (
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestCursor(t *testing.T) {
+	r := rand.New(rand.NewPCG(15, 16))
+	l := New[int]()
+	var want []int
+	for i := range 200 {
+		want = append(want, i)
+		l.PushBack(i)
+	}
+	c := l.Cursor()
+	check := func(i int) {
+		t.Helper()
+		if e := c.At(i); e == nil || e.Value != want[i] {
+			t.Fatalf("c.At(%d) = %v, want %d", i, e, want[i])
+		}
+	}
+	for i := range want {
+		check(i)
+	}
+	for i := range 1000 {
+		switch r.IntN(5) {
+		case 0:
+			// Modifying the list must invalidate the cached position.
+			j := r.IntN(len(want))
+			l.InsertBefore(-i, c.At(j))
+			want = slices.Insert(want, j, -i)
+		case 1:
+			j := r.IntN(len(want))
+			l.Remove(c.At(j))
+			want = slices.Delete(want, j, j+1)
+		case 2:
+			j := r.IntN(len(want))
+			if got := c.IndexOf(l.At(j)); got != j {
+				t.Fatalf("c.IndexOf(l.At(%d)) = %d", j, got)
+			}
+		default:
+			check(r.IntN(len(want)))
+		}
+	}
+	for i := range want {
+		check(i)
+	}
+	if c.At(len(want)) != nil || c.At(-len(want)-1) != nil {
+		t.Errorf("c.At out of range returned an element")
+	}
+	if c.IndexOf(New[int]().PushBack(1)) != -1 {
+		t.Errorf("c.IndexOf of foreign element is not -1")
+	}
+}
+
+// TestConcurrentReaders checks, when run with -race, that At and IndexOf
+// do not write to the list, so they may be used under a read lock.
+func TestConcurrentReaders(t *testing.T) {
+	var s SyncList[int]
+	for i := range 100 {
+		s.PushBack(i)
+	}
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				s.View(func(l *List[int]) {
+					if e := l.At(i); e == nil || l.IndexOf(e) != i {
+						t.Errorf("At and IndexOf disagree at %d", i)
+					}
+					l.Cursor().At(i)
+				})
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,57 @@
+package list
+
+import "fmt"
+
+// Building with the listdebug tag sets debug, compiling assertions into
+// every structural operation: ownership checks on insert, remove and move,
+// detection of elements removed twice, and sanity checks on the element
+// pool. The assertions panic on failure. Most cost a few comparisons per
+// operation; the SList ownership check walks the list, since its elements
+// carry no back-pointer. They are meant for tracking down corruption, not
+// for production.
+//
+//	go test -tags listdebug ./...
+
+// assertf panics with a formatted message if cond is false.
+func assertf(cond bool, format string, args ...any) {
+	if !cond {
+		panic("list: assertion failed: " + fmt.Sprintf(format, args...))
+	}
+}
+
+// debugInsert checks that e can be linked into l after at.
+func (l *List[E]) debugInsert(e, at *Element[E]) {
+	assertf(e.list == nil && e.next == nil && e.prev == nil, "insert: element %p is already linked into list %p", e, e.list)
+	assertf(at == &l.root || at.list == l, "insert: position %p belongs to list %p, not %p", at, at.list, l)
+}
+
+// debugRemove checks that e can be unlinked from l.
+func (l *List[E]) debugRemove(e *Element[E]) {
+	assertf(e.list == l, "remove: element %p belongs to list %p, not %p (removed twice?)", e, e.list, l)
+	assertf(e.next.prev == e && e.prev.next == e, "remove: element %p has inconsistent links", e)
+	assertf(l.len > 0, "remove: list %p is empty", l)
+}
+
+// debugMove checks that e can be moved after at within l.
+func (l *List[E]) debugMove(e, at *Element[E]) {
+	assertf(e.list == l, "move: element %p belongs to list %p, not %p", e, e.list, l)
+	assertf(at == &l.root || at.list == l, "move: position %p belongs to list %p, not %p", at, at.list, l)
+}
+
+// debugPool checks that e is detached and not already pooled, and marks
+// it pooled until newElement hands it out again.
+func (l *List[E]) debugPool(e *Element[E]) {
+	assertf(e.list == nil && e.next == nil && e.prev == nil, "pool: element %p is still linked", e)
+	assertf(!e.dbg.pooled(), "pool: element %p is pooled twice", e)
+	e.dbg.setPooled(true)
+}
+
+// debugOwns checks that mark is an element of l.
+func (l *SList[E]) debugOwns(mark *SElement[E]) {
+	for e := l.head; e != nil; e = e.next {
+		if e == mark {
+			return
+		}
+	}
+	panic(fmt.Sprintf("list: assertion failed: mark %p is not an element of list %p", mark, l))
+}
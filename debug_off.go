@@ -0,0 +1,12 @@
+//go:build !listdebug
+
+package list
+
+const debug = false
+
+// elemDebug is empty without the listdebug tag, so elements carry no
+// debugging state.
+type elemDebug struct{}
+
+func (d *elemDebug) pooled() bool   { return false }
+func (d *elemDebug) setPooled(bool) {}
@@ -0,0 +1,13 @@
+//go:build listdebug
+
+package list
+
+const debug = true
+
+// elemDebug is the debugging state of an element.
+type elemDebug struct {
+	inPool bool // set while the element is in a pool
+}
+
+func (d *elemDebug) pooled() bool     { return d.inPool }
+func (d *elemDebug) setPooled(b bool) { d.inPool = b }
@@ -0,0 +1,33 @@
+//go:build listdebug
+
+package list
+
+import "testing"
+
+func TestDebugAssertions(t *testing.T) {
+	l := New[int]()
+	e := l.PushBack(1)
+	l.PushBack(2)
+	other := New[int]()
+	o := other.PushBack(3)
+
+	mustPanic(t, "double remove", func() {
+		l.remove(e)
+		l.remove(e)
+	})
+	mustPanic(t, "insert linked element", func() { l.insert(o, &l.root) })
+	mustPanic(t, "move foreign element", func() { l.move(o, &l.root) })
+	mustPanic(t, "pool linked element", func() { l.poolElement(o) })
+	d := l.PushBack(4)
+	l.Remove(d) // pools d
+	mustPanic(t, "pool element twice", func() { l.poolElement(d) })
+	if e := l.PushBack(5); e != d {
+		t.Errorf("PushBack did not reuse the pooled element")
+	}
+	l.Remove(d) // d must no longer be marked as pooled
+
+	var sl, sother SList[int]
+	s := sl.PushFront(1)
+	mustPanic(t, "SList insert after foreign mark", func() { sother.InsertAfter(2, s) })
+	mustPanic(t, "SList remove after foreign mark", func() { sother.RemoveAfter(s) })
+}
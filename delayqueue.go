@@ -0,0 +1,116 @@
+package list
+
+import (
+	"sync"
+	"time"
+)
+
+// DelayQueue is a queue of values that each become available at a
+// scheduled time. Values are popped in order of their ready time, and only
+// once that time has been reached. It is safe for concurrent use.
+// A DelayQueue must be created with NewDelayQueue.
+type DelayQueue[E any] struct {
+	mu    sync.Mutex
+	items *SortedList[delayItem[E]]
+	ready chan struct{} // nil until Ready is first called
+	timer *time.Timer
+}
+
+type delayItem[E any] struct {
+	value   E
+	readyAt time.Time
+}
+
+// NewDelayQueue returns an empty delay queue.
+func NewDelayQueue[E any]() *DelayQueue[E] {
+	return &DelayQueue[E]{
+		items: NewSortedList(func(a, b delayItem[E]) int {
+			return a.readyAt.Compare(b.readyAt)
+		}),
+	}
+}
+
+// Len returns the number of values in q, ready or not.
+func (q *DelayQueue[E]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.items.Len()
+}
+
+// Push adds v to q, to become available at readyAt.
+func (q *DelayQueue[E]) Push(v E, readyAt time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if e := q.items.Insert(delayItem[E]{v, readyAt}); e == q.items.Front() {
+		q.armLocked()
+	}
+}
+
+// PopReady removes and returns the value with the earliest ready time if
+// that time is not after now. Otherwise it returns the zero value and
+// false.
+func (q *DelayQueue[E]) PopReady(now time.Time) (E, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	e := q.items.Front()
+	if e == nil || e.Value.readyAt.After(now) {
+		var zero E
+		return zero, false
+	}
+	v := q.items.Remove(e).value
+	q.armLocked()
+	return v, true
+}
+
+// NextReady returns the earliest ready time of the values in q, or
+// ok == false if q is empty.
+func (q *DelayQueue[E]) NextReady() (t time.Time, ok bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if e := q.items.Front(); e != nil {
+		return e.Value.readyAt, true
+	}
+	return t, false
+}
+
+// Ready returns a channel that receives a value when the earliest value
+// in q becomes ready. Receivers should then call PopReady in a loop until
+// it reports false. The channel is buffered and may fire spuriously, for
+// example after the ready value was popped by another goroutine.
+func (q *DelayQueue[E]) Ready() <-chan struct{} {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.ready == nil {
+		q.ready = make(chan struct{}, 1)
+		q.armLocked()
+	}
+	return q.ready
+}
+
+// armLocked schedules the Ready channel to fire at the ready time of the
+// front value. q.mu must be held.
+func (q *DelayQueue[E]) armLocked() {
+	if q.ready == nil {
+		return
+	}
+	e := q.items.Front()
+	if e == nil {
+		if q.timer != nil {
+			q.timer.Stop()
+		}
+		return
+	}
+	d := time.Until(e.Value.readyAt)
+	if q.timer == nil {
+		q.timer = time.AfterFunc(d, q.fire)
+	} else {
+		q.timer.Reset(d)
+	}
+}
+
+func (q *DelayQueue[E]) fire() {
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
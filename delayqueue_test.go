@@ -0,0 +1,53 @@
+package list
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDelayQueue(t *testing.T) {
+	q := NewDelayQueue[string]()
+	base := time.Unix(1000, 0)
+	q.Push("b", base.Add(2*time.Second))
+	q.Push("a", base.Add(time.Second))
+	q.Push("c", base.Add(2*time.Second))
+	if at, ok := q.NextReady(); !ok || !at.Equal(base.Add(time.Second)) {
+		t.Errorf("q.NextReady() = %v, %v", at, ok)
+	}
+
+	if v, ok := q.PopReady(base); ok {
+		t.Errorf("q.PopReady(base) = %q, want nothing ready", v)
+	}
+	var got []string
+	for {
+		v, ok := q.PopReady(base.Add(2 * time.Second))
+		if !ok {
+			break
+		}
+		got = append(got, v)
+	}
+	if len(got) != 3 || got[0] != "a" || got[1] != "b" || got[2] != "c" {
+		t.Errorf("popped %v, want [a b c]", got)
+	}
+	if _, ok := q.NextReady(); ok || q.Len() != 0 {
+		t.Errorf("q not empty")
+	}
+}
+
+func TestDelayQueueReady(t *testing.T) {
+	q := NewDelayQueue[int]()
+	ready := q.Ready()
+	q.Push(2, time.Now().Add(time.Hour))
+	q.Push(1, time.Now().Add(10*time.Millisecond))
+	select {
+	case <-ready:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Ready channel did not fire")
+	}
+	if v, ok := q.PopReady(time.Now()); !ok || v != 1 {
+		t.Errorf("q.PopReady() = %d, %v; want 1, true", v, ok)
+	}
+	if _, ok := q.PopReady(time.Now()); ok {
+		t.Errorf("second value ready early")
+	}
+}
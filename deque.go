@@ -0,0 +1,188 @@
+package list
+
+import "iter"
+
+// dequeBlockSize is the number of values held by each Deque block.
+const dequeBlockSize = 64
+
+// Deque is a double-ended queue stored as a doubly linked list of
+// fixed-size blocks. Compared with a List it allocates once per block
+// rather than once per value and keeps neighbouring values adjacent in
+// memory, at the cost of not handing out stable elements.
+// The zero value is an empty deque ready to use.
+// A Deque is not safe for concurrent use.
+type Deque[E any] struct {
+	head, tail *dequeBlock[E]
+	front      int // index of the first value in head
+	back       int // index one past the last value in tail
+	len        int
+	spare      *dequeBlock[E] // most recently emptied block, kept for reuse
+}
+
+type dequeBlock[E any] struct {
+	vals       [dequeBlockSize]E
+	prev, next *dequeBlock[E]
+}
+
+// NewDeque returns an empty deque.
+func NewDeque[E any]() *Deque[E] { return new(Deque[E]) }
+
+// Len returns the number of values in d.
+func (d *Deque[E]) Len() int { return d.len }
+
+// lazyInit gives an empty deque a block with room at both ends.
+func (d *Deque[E]) lazyInit() {
+	if d.head == nil {
+		d.head = d.newBlock()
+		d.tail = d.head
+		d.front, d.back = dequeBlockSize/2, dequeBlockSize/2
+	}
+}
+
+func (d *Deque[E]) newBlock() *dequeBlock[E] {
+	if b := d.spare; b != nil {
+		d.spare = nil
+		return b
+	}
+	return new(dequeBlock[E])
+}
+
+// freeBlock unlinks b, whose values must already be zeroed, and keeps it
+// as the spare block.
+func (d *Deque[E]) freeBlock(b *dequeBlock[E]) {
+	b.prev, b.next = nil, nil
+	d.spare = b
+}
+
+// PushBack adds v at the back of d.
+func (d *Deque[E]) PushBack(v E) {
+	d.lazyInit()
+	if d.back == dequeBlockSize {
+		b := d.newBlock()
+		b.prev = d.tail
+		d.tail.next = b
+		d.tail = b
+		d.back = 0
+	}
+	d.tail.vals[d.back] = v
+	d.back++
+	d.len++
+}
+
+// PushFront adds v at the front of d.
+func (d *Deque[E]) PushFront(v E) {
+	d.lazyInit()
+	if d.front == 0 {
+		b := d.newBlock()
+		b.next = d.head
+		d.head.prev = b
+		d.head = b
+		d.front = dequeBlockSize
+	}
+	d.front--
+	d.head.vals[d.front] = v
+	d.len++
+}
+
+// PopFront removes and returns the value at the front of d, or ok == false
+// if d is empty.
+func (d *Deque[E]) PopFront() (v E, ok bool) {
+	if d.len == 0 {
+		return v, false
+	}
+	var zero E
+	v = d.head.vals[d.front]
+	d.head.vals[d.front] = zero
+	d.front++
+	d.len--
+	switch {
+	case d.len == 0:
+		d.reset()
+	case d.front == dequeBlockSize:
+		b := d.head
+		d.head = b.next
+		d.head.prev = nil
+		d.front = 0
+		d.freeBlock(b)
+	}
+	return v, true
+}
+
+// PopBack removes and returns the value at the back of d, or ok == false
+// if d is empty.
+func (d *Deque[E]) PopBack() (v E, ok bool) {
+	if d.len == 0 {
+		return v, false
+	}
+	var zero E
+	d.back--
+	v = d.tail.vals[d.back]
+	d.tail.vals[d.back] = zero
+	d.len--
+	switch {
+	case d.len == 0:
+		d.reset()
+	case d.back == 0:
+		b := d.tail
+		d.tail = b.prev
+		d.tail.next = nil
+		d.back = dequeBlockSize
+		d.freeBlock(b)
+	}
+	return v, true
+}
+
+// reset recentres an emptied deque on its head block. The emptied deque
+// may still span two blocks when its last value sat at a block boundary.
+func (d *Deque[E]) reset() {
+	if b := d.head.next; b != nil {
+		d.head.next = nil
+		d.freeBlock(b)
+	}
+	d.tail = d.head
+	d.front, d.back = dequeBlockSize/2, dequeBlockSize/2
+}
+
+// Front returns the value at the front of d, or ok == false if d is empty.
+func (d *Deque[E]) Front() (v E, ok bool) {
+	if d.len == 0 {
+		return v, false
+	}
+	return d.head.vals[d.front], true
+}
+
+// Back returns the value at the back of d, or ok == false if d is empty.
+func (d *Deque[E]) Back() (v E, ok bool) {
+	if d.len == 0 {
+		return v, false
+	}
+	return d.tail.vals[d.back-1], true
+}
+
+// Clear removes all values from d.
+func (d *Deque[E]) Clear() {
+	*d = Deque[E]{}
+}
+
+// All returns an iterator over the values of d from front to back.
+// d must not be modified during iteration.
+func (d *Deque[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		if d.len == 0 {
+			return
+		}
+		i := d.front
+		for b := d.head; b != nil; b = b.next {
+			end := dequeBlockSize
+			if b == d.tail {
+				end = d.back
+			}
+			for ; i < end; i++ {
+				if !yield(b.vals[i]) {
+					return
+				}
+			}
+			i = 0
+		}
+	}
+}
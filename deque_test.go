@@ -0,0 +1,132 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDeque(t *testing.T) {
+	var d Deque[int]
+	if _, ok := d.PopFront(); ok {
+		t.Errorf("PopFront on empty deque ok")
+	}
+	if _, ok := d.Back(); ok {
+		t.Errorf("Back on empty deque ok")
+	}
+
+	// Push enough to span several blocks at both ends.
+	const n = 3 * dequeBlockSize
+	var want []int
+	for i := range n {
+		d.PushBack(i)
+		d.PushFront(-i - 1)
+		want = append(want, i)
+		want = slices.Insert(want, 0, -i-1)
+	}
+	if d.Len() != 2*n {
+		t.Errorf("d.Len() = %d, want %d", d.Len(), 2*n)
+	}
+	if got := slices.Collect(d.All()); !slices.Equal(got, want) {
+		t.Errorf("d.All() differs from pushed values")
+	}
+	if v, _ := d.Front(); v != want[0] {
+		t.Errorf("d.Front() = %d, want %d", v, want[0])
+	}
+	if v, _ := d.Back(); v != want[len(want)-1] {
+		t.Errorf("d.Back() = %d, want %d", v, want[len(want)-1])
+	}
+
+	for len(want) > 0 {
+		v, ok := d.PopFront()
+		if !ok || v != want[0] {
+			t.Fatalf("d.PopFront() = %d, %v; want %d, true", v, ok, want[0])
+		}
+		want = want[1:]
+		if len(want) == 0 {
+			break
+		}
+		v, ok = d.PopBack()
+		if !ok || v != want[len(want)-1] {
+			t.Fatalf("d.PopBack() = %d, %v; want %d, true", v, ok, want[len(want)-1])
+		}
+		want = want[:len(want)-1]
+	}
+	if d.Len() != 0 || d.head != d.tail {
+		t.Errorf("emptied deque not reset: len %d", d.Len())
+	}
+
+	// Emptying at a block boundary must leave the deque usable.
+	for i := range dequeBlockSize / 2 {
+		d.PushBack(i)
+	}
+	d.PushBack(-1)
+	for d.Len() > 0 {
+		d.PopFront()
+	}
+	d.PushFront(1)
+	d.PushBack(2)
+	if got, want := slices.Collect(d.All()), []int{1, 2}; !slices.Equal(got, want) {
+		t.Errorf("d.All() = %v, want %v", got, want)
+	}
+	d.Clear()
+	if d.Len() != 0 || len(slices.Collect(d.All())) != 0 {
+		t.Errorf("d not empty after Clear")
+	}
+}
+
+// benchSink keeps benchmark results live.
+var benchSink int
+
+func BenchmarkDequePushPop(b *testing.B) {
+	var d Deque[int]
+	for range b.N {
+		for j := range 1024 {
+			d.PushBack(j)
+		}
+		for range 1024 {
+			d.PopFront()
+		}
+	}
+}
+
+func BenchmarkListPushPop(b *testing.B) {
+	var l List[int]
+	for range b.N {
+		for j := range 1024 {
+			l.PushBack(j)
+		}
+		for range 1024 {
+			l.PopFront()
+		}
+	}
+}
+
+func BenchmarkDequeIterate(b *testing.B) {
+	var d Deque[int]
+	for i := range 1 << 16 {
+		d.PushBack(i)
+	}
+	b.ResetTimer()
+	for range b.N {
+		sum := 0
+		for v := range d.All() {
+			sum += v
+		}
+		benchSink = sum
+	}
+}
+
+func BenchmarkListIterate(b *testing.B) {
+	var l List[int]
+	for i := range 1 << 16 {
+		l.PushBack(i)
+	}
+	b.ResetTimer()
+	for range b.N {
+		sum := 0
+		for v := range l.All() {
+			sum += v
+		}
+		benchSink = sum
+	}
+}
@@ -0,0 +1,73 @@
+package list
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// DumpDOT writes the link structure of list l to w as a Graphviz DOT graph,
+// including the sentinel, every next edge (solid) and every prev edge
+// (dashed). Each element is labelled with label(e.Value), or fmt.Sprint of
+// the value if label is nil. Elements that do not belong to l are drawn in
+// red.
+//
+// DumpDOT follows links without assuming they are consistent, visiting each
+// element at most once, so it can be used to inspect a corrupted list.
+func (l *List[E]) DumpDOT(w io.Writer, label func(E) string) error {
+	if label == nil {
+		label = func(v E) string { return fmt.Sprint(v) }
+	}
+	dw := &dotWriter{w: w}
+	dw.printf("digraph list {\n\trankdir=LR;\n\tnode [shape=box];\n")
+
+	root := &l.root
+	seen := map[*Element[E]]bool{}
+	var order []*Element[E]
+	var visit func(e *Element[E])
+	visit = func(e *Element[E]) {
+		for e != nil && !seen[e] {
+			seen[e] = true
+			order = append(order, e)
+			e = e.next
+		}
+	}
+	visit(root)
+	// Reach elements only linked through prev pointers as well.
+	for i := 0; i < len(order); i++ {
+		visit(order[i].prev)
+	}
+
+	for _, e := range order {
+		switch {
+		case e == root:
+			dw.printf("\t\"%p\" [label=%s, shape=ellipse];\n", e, strconv.Quote(fmt.Sprintf("root\nlen=%d", l.len)))
+		case e.list != l:
+			dw.printf("\t\"%p\" [label=%s, color=red];\n", e, strconv.Quote(label(e.Value)))
+		default:
+			dw.printf("\t\"%p\" [label=%s];\n", e, strconv.Quote(label(e.Value)))
+		}
+	}
+	for _, e := range order {
+		if e.next != nil {
+			dw.printf("\t\"%p\" -> \"%p\";\n", e, e.next)
+		}
+		if e.prev != nil {
+			dw.printf("\t\"%p\" -> \"%p\" [style=dashed];\n", e, e.prev)
+		}
+	}
+	dw.printf("}\n")
+	return dw.err
+}
+
+// dotWriter writes formatted output, remembering the first error.
+type dotWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (d *dotWriter) printf(format string, args ...any) {
+	if d.err == nil {
+		_, d.err = fmt.Fprintf(d.w, format, args...)
+	}
+}
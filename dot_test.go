@@ -0,0 +1,52 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestDumpDOT(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+
+	var b strings.Builder
+	if err := l.DumpDOT(&b, nil); err != nil {
+		t.Fatalf("DumpDOT: %v", err)
+	}
+	out := b.String()
+	for _, want := range []string{
+		"digraph list {",
+		fmt.Sprintf("\"%p\" [label=\"root\\nlen=2\"", &l.root),
+		fmt.Sprintf("\"%p\" [label=\"1\"];", e1),
+		fmt.Sprintf("\"%p\" -> \"%p\";", e1, e2),
+		fmt.Sprintf("\"%p\" -> \"%p\" [style=dashed];", e2, e1),
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("DumpDOT output missing %q:\n%s", want, out)
+		}
+	}
+
+	// A corrupted list is still dumped, with the foreign element marked.
+	stray := &Element[int]{Value: 9}
+	stray.next = e2
+	e1.next = stray
+	b.Reset()
+	if err := l.DumpDOT(&b, func(v int) string { return fmt.Sprintf("v%d", v) }); err != nil {
+		t.Fatalf("DumpDOT: %v", err)
+	}
+	if want := fmt.Sprintf("\"%p\" [label=\"v9\", color=red];", stray); !strings.Contains(b.String(), want) {
+		t.Errorf("DumpDOT output missing %q:\n%s", want, b.String())
+	}
+	e1.next = e2
+
+	if err := l.DumpDOT(failWriter{}, nil); err == nil {
+		t.Errorf("DumpDOT to failing writer returned nil")
+	}
+}
+
+type failWriter struct{}
+
+func (failWriter) Write([]byte) (int, error) { return 0, errors.New("write failed") }
@@ -0,0 +1,172 @@
+package list
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// binaryVersion is the format version written by EncodeBinary.
+const binaryVersion = 1
+
+// EncodeBinary writes list l to w in a compact binary form: a format version
+// byte, the number of elements as a uvarint, and then each value in order
+// as written by enc.
+func (l *List[E]) EncodeBinary(w io.Writer, enc func(io.Writer, E) error) error {
+	var hdr [1 + binary.MaxVarintLen64]byte
+	hdr[0] = binaryVersion
+	n := 1 + binary.PutUvarint(hdr[1:], uint64(l.Len()))
+	if _, err := w.Write(hdr[:n]); err != nil {
+		return err
+	}
+	for e := l.Front(); e != nil; e = e.Next() {
+		if err := enc(w, e.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeBinary reads a list written by EncodeBinary from r, decoding each
+// value with dec, and appends the values to the back of list l. It reads
+// exactly the bytes of the encoded list from r, so further data may follow.
+// If an error occurs, the values decoded so far remain in l.
+func (l *List[E]) DecodeBinary(r io.Reader, dec func(io.Reader) (E, error)) error {
+	br := byteReader{r: r}
+	version, err := br.ReadByte()
+	if err != nil {
+		return err
+	}
+	if version != binaryVersion {
+		return fmt.Errorf("list: unsupported binary format version %d", version)
+	}
+	n, err := binary.ReadUvarint(&br)
+	if err != nil {
+		return unexpectedEOF(err)
+	}
+	l.lazyInit()
+	for ; n > 0; n-- {
+		v, err := dec(r)
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		l.insertValue(v, l.root.prev)
+	}
+	return nil
+}
+
+// byteReader adapts an io.Reader to an io.ByteReader without reading ahead.
+type byteReader struct {
+	r   io.Reader
+	buf [1]byte
+}
+
+func (b *byteReader) ReadByte() (byte, error) {
+	if br, ok := b.r.(io.ByteReader); ok {
+		return br.ReadByte()
+	}
+	_, err := io.ReadFull(b.r, b.buf[:])
+	return b.buf[0], err
+}
+
+// unexpectedEOF converts io.EOF, which is only valid before a list starts,
+// into io.ErrUnexpectedEOF.
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// streamVersion is the format version written by Encoder.
+const streamVersion = 2
+
+// An Encoder writes lists to an output stream. Each value is written as a
+// length-prefixed frame as soon as it is encoded, so arbitrarily long lists
+// can be written using memory proportional to the largest single value.
+type Encoder[E any] struct {
+	w     *bufio.Writer
+	enc   func(io.Writer, E) error
+	frame bytes.Buffer
+}
+
+// NewEncoder returns an encoder that writes to w, encoding each value with enc.
+func NewEncoder[E any](w io.Writer, enc func(io.Writer, E) error) *Encoder[E] {
+	return &Encoder[E]{w: bufio.NewWriter(w), enc: enc}
+}
+
+// EncodeList writes list l to the stream and flushes it.
+// Several lists may be written to the same stream in sequence.
+func (e *Encoder[E]) EncodeList(l *List[E]) error {
+	if err := e.w.WriteByte(streamVersion); err != nil {
+		return err
+	}
+	var hdr [binary.MaxVarintLen64]byte
+	for x := l.Front(); x != nil; x = x.Next() {
+		e.frame.Reset()
+		if err := e.enc(&e.frame, x.Value); err != nil {
+			return err
+		}
+		// Frame lengths are offset by one so that zero can end the list.
+		n := binary.PutUvarint(hdr[:], uint64(e.frame.Len())+1)
+		if _, err := e.w.Write(hdr[:n]); err != nil {
+			return err
+		}
+		if _, err := e.frame.WriteTo(e.w); err != nil {
+			return err
+		}
+	}
+	if err := e.w.WriteByte(0); err != nil {
+		return err
+	}
+	return e.w.Flush()
+}
+
+// A Decoder reads lists written by an Encoder from an input stream.
+// The Decoder buffers its input and may read data from r beyond the lists
+// it decodes.
+type Decoder[E any] struct {
+	r   *bufio.Reader
+	dec func(io.Reader) (E, error)
+}
+
+// NewDecoder returns a decoder that reads from r, decoding each value with dec.
+func NewDecoder[E any](r io.Reader, dec func(io.Reader) (E, error)) *Decoder[E] {
+	return &Decoder[E]{r: bufio.NewReader(r), dec: dec}
+}
+
+// DecodeInto reads the next list from the stream and appends its values to
+// the back of list l, one at a time as they are read. It returns io.EOF if
+// the stream holds no further lists. If another error occurs, the values
+// decoded so far remain in l.
+func (d *Decoder[E]) DecodeInto(l *List[E]) error {
+	version, err := d.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	if version != streamVersion {
+		return fmt.Errorf("list: unsupported stream format version %d", version)
+	}
+	l.lazyInit()
+	frame := io.LimitedReader{R: d.r}
+	for {
+		n, err := binary.ReadUvarint(d.r)
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		if n == 0 {
+			return nil
+		}
+		frame.N = int64(n - 1)
+		v, err := d.dec(&frame)
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		if frame.N != 0 {
+			return fmt.Errorf("list: value decoder left %d bytes of its frame unread", frame.N)
+		}
+		l.insertValue(v, l.root.prev)
+	}
+}
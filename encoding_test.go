@@ -0,0 +1,115 @@
+package list
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+)
+
+func encodeInt(w io.Writer, v int32) error {
+	return binary.Write(w, binary.LittleEndian, v)
+}
+
+func decodeInt(r io.Reader) (int32, error) {
+	var v int32
+	err := binary.Read(r, binary.LittleEndian, &v)
+	return v, err
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	for _, vals := range [][]int32{nil, {1}, {1, -2, 300000}} {
+		var buf bytes.Buffer
+		if err := NewFromSlice(vals).EncodeBinary(&buf, encodeInt); err != nil {
+			t.Fatalf("EncodeBinary: %v", err)
+		}
+		buf.WriteString("trailer")
+
+		var l List[int32]
+		if err := l.DecodeBinary(&buf, decodeInt); err != nil {
+			t.Fatalf("DecodeBinary: %v", err)
+		}
+		if !Equal(&l, NewFromSlice(vals)) {
+			t.Errorf("decoded %v, want %v", l.ToSlice(), vals)
+		}
+		if rest := buf.String(); rest != "trailer" {
+			t.Errorf("DecodeBinary left %q unread, want %q", rest, "trailer")
+		}
+	}
+}
+
+func TestDecodeBinaryErrors(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewFromSlice([]int32{1, 2}).EncodeBinary(&buf, encodeInt); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	var l List[int32]
+	if err := l.DecodeBinary(bytes.NewReader(data[:len(data)-1]), decodeInt); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("truncated input: err = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+	bad := append([]byte{99}, data[1:]...)
+	if err := l.DecodeBinary(bytes.NewReader(bad), decodeInt); err == nil {
+		t.Errorf("bad version: err = nil")
+	}
+	if err := l.DecodeBinary(bytes.NewReader(nil), decodeInt); err != io.EOF {
+		t.Errorf("empty input: err = %v, want %v", err, io.EOF)
+	}
+}
+
+func TestEncoderDecoder(t *testing.T) {
+	lists := [][]string{{"a", "", "bc"}, nil, {"long value"}}
+	encStr := func(w io.Writer, s string) error {
+		_, err := io.WriteString(w, s)
+		return err
+	}
+	decStr := func(r io.Reader) (string, error) {
+		b, err := io.ReadAll(r)
+		return string(b), err
+	}
+
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf, encStr)
+	for _, vals := range lists {
+		if err := enc.EncodeList(NewFromSlice(vals)); err != nil {
+			t.Fatalf("EncodeList: %v", err)
+		}
+	}
+
+	dec := NewDecoder(&buf, decStr)
+	for _, vals := range lists {
+		var l List[string]
+		if err := dec.DecodeInto(&l); err != nil {
+			t.Fatalf("DecodeInto: %v", err)
+		}
+		if !Equal(&l, NewFromSlice(vals)) {
+			t.Errorf("decoded %q, want %q", l.ToSlice(), vals)
+		}
+	}
+	if err := dec.DecodeInto(new(List[string])); err != io.EOF {
+		t.Errorf("DecodeInto at end of stream = %v, want %v", err, io.EOF)
+	}
+}
+
+func TestDecoderShortRead(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf, encodeInt).EncodeList(NewFromSlice([]int32{1, 2})); err != nil {
+		t.Fatal(err)
+	}
+	// A decoder that consumes less than its frame is reported.
+	short := func(r io.Reader) (int32, error) {
+		var b [1]byte
+		_, err := io.ReadFull(r, b[:])
+		return int32(b[0]), err
+	}
+	var l List[int32]
+	if err := NewDecoder(bytes.NewReader(buf.Bytes()), short).DecodeInto(&l); err == nil {
+		t.Errorf("DecodeInto with short decoder: err = nil")
+	}
+	data := buf.Bytes()
+	if err := NewDecoder(bytes.NewReader(data[:len(data)-2]), decodeInt).DecodeInto(&l); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("truncated stream: err = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
@@ -0,0 +1,36 @@
+package list
+
+import (
+	"fmt"
+	"io"
+)
+
+// String returns the values of list l formatted like a slice, e.g. "[a b c]".
+func (l *List[E]) String() string {
+	return fmt.Sprintf("%v", l)
+}
+
+// GoString returns a Go expression that builds a list equal to l,
+// e.g. "list.NewFromSlice([]int{1, 2, 3})".
+func (l *List[E]) GoString() string {
+	return "list.NewFromSlice(" + fmt.Sprintf("%#v", l.ToSlice()) + ")"
+}
+
+// Format implements fmt.Formatter. The list is printed like a slice of its
+// values: the verb, flags, width and precision are applied to each value,
+// and %#v prints the GoString form.
+func (l *List[E]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		io.WriteString(f, l.GoString())
+		return
+	}
+	format := fmt.FormatString(f, verb)
+	io.WriteString(f, "[")
+	for e := l.Front(); e != nil; e = e.Next() {
+		if e != l.root.next {
+			io.WriteString(f, " ")
+		}
+		fmt.Fprintf(f, format, e.Value)
+	}
+	io.WriteString(f, "]")
+}
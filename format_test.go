@@ -0,0 +1,38 @@
+package list
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	type point struct{ X, Y int }
+	tests := []struct {
+		format string
+		arg    any
+		want   string
+	}{
+		{"%v", NewFromSlice([]int{1, 2, 3}), "[1 2 3]"},
+		{"%v", New[int](), "[]"},
+		{"%v", new(List[int]), "[]"},
+		{"%s", NewFromSlice([]string{"a", "b"}), "[a b]"},
+		{"%q", NewFromSlice([]string{"a", "b"}), `["a" "b"]`},
+		{"%03d", NewFromSlice([]int{1, 20}), "[001 020]"},
+		{"%+v", NewFromSlice([]point{{1, 2}}), "[{X:1 Y:2}]"},
+		{"%#v", NewFromSlice([]int{1, 2}), "list.NewFromSlice([]int{1, 2})"},
+		{"%x", NewFromSlice([]int{255, 16}), "[ff 10]"},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, tt.arg); got != tt.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+
+	l := NewFromSlice([]string{"x", "y"})
+	if got, want := l.String(), "[x y]"; got != want {
+		t.Errorf("l.String() = %q, want %q", got, want)
+	}
+	if got, want := l.GoString(), `list.NewFromSlice([]string{"x", "y"})`; got != want {
+		t.Errorf("l.GoString() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,287 @@
+package list
+
+// EqualFunc reports whether lists l and other have the same length and
+// eq(a, b) reports true for each pair of values in order.
+func (l *List[E]) EqualFunc(other *List[E], eq func(a, b E) bool) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for a, b := l.Front(), other.Front(); a != nil; a, b = a.Next(), b.Next() {
+		if !eq(a.Value, b.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal reports whether lists a and b have the same length and contain
+// equal values in the same order.
+func Equal[E comparable](a, b *List[E]) bool {
+	return a.EqualFunc(b, func(x, y E) bool { return x == y })
+}
+
+// IndexFunc returns the position of the first value v in list l satisfying
+// f(v), or -1 if none do.
+func (l *List[E]) IndexFunc(f func(E) bool) int {
+	i := 0
+	for e := l.Front(); e != nil; e = e.Next() {
+		if f(e.Value) {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// Index returns the position of the first occurrence of v in list l,
+// or -1 if not present.
+func Index[E comparable](l *List[E], v E) int {
+	return l.IndexFunc(func(x E) bool { return x == v })
+}
+
+// Contains reports whether v is present in list l.
+func Contains[E comparable](l *List[E], v E) bool {
+	return Index(l, v) >= 0
+}
+
+// FindFunc returns the first element of list l whose value satisfies f,
+// or nil if none do.
+func (l *List[E]) FindFunc(f func(E) bool) *Element[E] {
+	for e := l.Front(); e != nil; e = e.Next() {
+		if f(e.Value) {
+			return e
+		}
+	}
+	return nil
+}
+
+// FindLastFunc returns the last element of list l whose value satisfies f,
+// or nil if none do.
+func (l *List[E]) FindLastFunc(f func(E) bool) *Element[E] {
+	for e := l.Back(); e != nil; e = e.Prev() {
+		if f(e.Value) {
+			return e
+		}
+	}
+	return nil
+}
+
+// DeleteFunc removes every element of list l whose value satisfies del and
+// returns the number of elements removed. Removed elements are recycled,
+// so pointers to them must not be used afterwards.
+func (l *List[E]) DeleteFunc(del func(E) bool) int {
+	n := 0
+	var next *Element[E]
+	for e := l.Front(); e != nil; e = next {
+		next = e.Next()
+		if del(e.Value) {
+			l.remove(e)
+			n++
+		}
+	}
+	return n
+}
+
+// Filter returns a new list containing, in order, the values of list l that
+// satisfy keep. List l is not modified.
+func (l *List[E]) Filter(keep func(E) bool) *List[E] {
+	r := New[E]()
+	for e := l.Front(); e != nil; e = e.Next() {
+		if keep(e.Value) {
+			r.insertValue(e.Value, r.root.prev)
+		}
+	}
+	return r
+}
+
+// Map returns a new list containing f(v) for each value v of list l, in order.
+func Map[E, T any](l *List[E], f func(E) T) *List[T] {
+	r := New[T]()
+	for e := l.Front(); e != nil; e = e.Next() {
+		r.insertValue(f(e.Value), r.root.prev)
+	}
+	return r
+}
+
+// Reduce folds the values of list l from front to back into an accumulator,
+// starting from init, and returns the final accumulator.
+func Reduce[E, A any](l *List[E], init A, f func(A, E) A) A {
+	acc := init
+	for e := l.Front(); e != nil; e = e.Next() {
+		acc = f(acc, e.Value)
+	}
+	return acc
+}
+
+// Do calls f on each value of list l from front to back, stopping early if
+// f returns false. The behavior of Do is undefined if f changes l.
+func (l *List[E]) Do(f func(E) bool) {
+	for e := l.Front(); e != nil; e = e.Next() {
+		if !f(e.Value) {
+			return
+		}
+	}
+}
+
+// CompactFunc replaces each run of consecutive values of list l for which eq
+// reports true with its first element, and returns the number of elements
+// removed. Removed elements are recycled.
+func (l *List[E]) CompactFunc(eq func(a, b E) bool) int {
+	n := 0
+	e := l.Front()
+	if e == nil {
+		return 0
+	}
+	for next := e.Next(); next != nil; next = e.Next() {
+		if eq(e.Value, next.Value) {
+			l.remove(next)
+			n++
+		} else {
+			e = next
+		}
+	}
+	return n
+}
+
+// Compact replaces each run of consecutive equal values of list l with a
+// single copy, keeping the first element of the run, and returns the number
+// of elements removed.
+func Compact[E comparable](l *List[E]) int {
+	return l.CompactFunc(func(a, b E) bool { return a == b })
+}
+
+// Dedup removes every element of list l whose value already appeared earlier
+// in l, preserving the order of first occurrences, and returns the number of
+// elements removed. Removed elements are recycled.
+func Dedup[E comparable](l *List[E]) int {
+	seen := make(map[E]struct{}, l.Len())
+	return l.DeleteFunc(func(v E) bool {
+		if _, ok := seen[v]; ok {
+			return true
+		}
+		seen[v] = struct{}{}
+		return false
+	})
+}
+
+// CountFunc returns the number of values of list l that satisfy f.
+func (l *List[E]) CountFunc(f func(E) bool) int {
+	n := 0
+	for e := l.Front(); e != nil; e = e.Next() {
+		if f(e.Value) {
+			n++
+		}
+	}
+	return n
+}
+
+// Count returns the number of occurrences of v in list l.
+func Count[E comparable](l *List[E], v E) int {
+	return l.CountFunc(func(x E) bool { return x == v })
+}
+
+// AnyFunc reports whether at least one value of list l satisfies f.
+// It stops at the first value that does.
+func (l *List[E]) AnyFunc(f func(E) bool) bool {
+	return l.FindFunc(f) != nil
+}
+
+// AllFunc reports whether every value of list l satisfies f. It is true for
+// an empty list and stops at the first value that does not.
+// (All is taken by the value iterator, hence the Func suffix.)
+func (l *List[E]) AllFunc(f func(E) bool) bool {
+	return l.FindFunc(func(v E) bool { return !f(v) }) == nil
+}
+
+// NoneFunc reports whether no value of list l satisfies f.
+// It stops at the first value that does.
+func (l *List[E]) NoneFunc(f func(E) bool) bool {
+	return !l.AnyFunc(f)
+}
+
+// Pair holds two values of possibly different types.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip returns a new list pairing the values of lists a and b by position.
+// The result is as long as the shorter of the two lists.
+func Zip[A, B any](a *List[A], b *List[B]) *List[Pair[A, B]] {
+	r := New[Pair[A, B]]()
+	for x, y := a.Front(), b.Front(); x != nil && y != nil; x, y = x.Next(), y.Next() {
+		r.insertValue(Pair[A, B]{x.Value, y.Value}, r.root.prev)
+	}
+	return r
+}
+
+// Interleave returns a new list that takes values from the given lists in
+// round-robin order: the first value of each list, then the second value of
+// each list, and so on. Exhausted lists are skipped. The inputs are not
+// modified.
+func Interleave[E any](lists ...*List[E]) *List[E] {
+	r := New[E]()
+	cur := make([]*Element[E], 0, len(lists))
+	for _, l := range lists {
+		if e := l.Front(); e != nil {
+			cur = append(cur, e)
+		}
+	}
+	for len(cur) > 0 {
+		live := cur[:0]
+		for _, e := range cur {
+			r.insertValue(e.Value, r.root.prev)
+			if next := e.Next(); next != nil {
+				live = append(live, next)
+			}
+		}
+		cur = live
+	}
+	return r
+}
+
+// GroupBy returns a map from each key produced by key to a new list holding,
+// in order, the values of list l with that key. List l is not modified.
+func GroupBy[E any, K comparable](l *List[E], key func(E) K) map[K]*List[E] {
+	groups := make(map[K]*List[E])
+	for e := l.Front(); e != nil; e = e.Next() {
+		k := key(e.Value)
+		g := groups[k]
+		if g == nil {
+			g = New[E]()
+			groups[k] = g
+		}
+		g.insertValue(e.Value, g.root.prev)
+	}
+	return groups
+}
+
+// MinFunc returns the first element of list l with the minimal value as
+// determined by cmp, or nil if l is empty.
+func (l *List[E]) MinFunc(cmp func(a, b E) int) *Element[E] {
+	m := l.Front()
+	if m == nil {
+		return nil
+	}
+	for e := m.Next(); e != nil; e = e.Next() {
+		if cmp(e.Value, m.Value) < 0 {
+			m = e
+		}
+	}
+	return m
+}
+
+// MaxFunc returns the first element of list l with the maximal value as
+// determined by cmp, or nil if l is empty.
+func (l *List[E]) MaxFunc(cmp func(a, b E) int) *Element[E] {
+	m := l.Front()
+	if m == nil {
+		return nil
+	}
+	for e := m.Next(); e != nil; e = e.Next() {
+		if cmp(e.Value, m.Value) > 0 {
+			m = e
+		}
+	}
+	return m
+}
@@ -0,0 +1,294 @@
+package list
+
+import (
+	"cmp"
+	"strings"
+	"testing"
+)
+
+func TestEqual(t *testing.T) {
+	var z List[int]
+	tests := []struct {
+		a, b *List[int]
+		want bool
+	}{
+		{&z, New[int](), true},
+		{NewFromSlice([]int{1, 2}), NewFromSlice([]int{1, 2}), true},
+		{NewFromSlice([]int{1, 2}), NewFromSlice([]int{1, 3}), false},
+		{NewFromSlice([]int{1, 2}), NewFromSlice([]int{1, 2, 3}), false},
+		{NewFromSlice([]int{1}), &z, false},
+	}
+	for _, tt := range tests {
+		if got := Equal(tt.a, tt.b); got != tt.want {
+			t.Errorf("Equal(%v, %v) = %v, want %v", tt.a.ToSlice(), tt.b.ToSlice(), got, tt.want)
+		}
+	}
+
+	a := NewFromSlice([]string{"a", "B"})
+	b := NewFromSlice([]string{"A", "b"})
+	if !a.EqualFunc(b, strings.EqualFold) {
+		t.Errorf("EqualFunc with strings.EqualFold = false, want true")
+	}
+}
+
+func TestIndex(t *testing.T) {
+	l := NewFromSlice([]int{5, 6, 7, 6})
+	for _, tt := range []struct{ v, want int }{{5, 0}, {6, 1}, {7, 2}, {8, -1}} {
+		if got := Index(l, tt.v); got != tt.want {
+			t.Errorf("Index(l, %d) = %d, want %d", tt.v, got, tt.want)
+		}
+		if got := Contains(l, tt.v); got != (tt.want >= 0) {
+			t.Errorf("Contains(l, %d) = %v, want %v", tt.v, got, tt.want >= 0)
+		}
+	}
+	if got := l.IndexFunc(func(v int) bool { return v > 6 }); got != 2 {
+		t.Errorf("l.IndexFunc(v > 6) = %d, want 2", got)
+	}
+	var z List[int]
+	if got := Index(&z, 1); got != -1 {
+		t.Errorf("Index on empty list = %d, want -1", got)
+	}
+}
+
+func TestFindFunc(t *testing.T) {
+	l := New[int]()
+	l.PushBack(1)
+	e2 := l.PushBack(2)
+	l.PushBack(3)
+	e4 := l.PushBack(4)
+	even := func(v int) bool { return v%2 == 0 }
+	if e := l.FindFunc(even); e != e2 {
+		t.Errorf("l.FindFunc(even) = %p, want %p", e, e2)
+	}
+	if e := l.FindLastFunc(even); e != e4 {
+		t.Errorf("l.FindLastFunc(even) = %p, want %p", e, e4)
+	}
+	never := func(int) bool { return false }
+	if e := l.FindFunc(never); e != nil {
+		t.Errorf("l.FindFunc(never) = %p, want nil", e)
+	}
+	if e := l.FindLastFunc(never); e != nil {
+		t.Errorf("l.FindLastFunc(never) = %p, want nil", e)
+	}
+}
+
+func TestDeleteFunc(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	l.PushBack(2)
+	e3 := l.PushBack(3)
+	l.PushBack(4)
+	if n := l.DeleteFunc(func(v int) bool { return v%2 == 0 }); n != 2 {
+		t.Errorf("l.DeleteFunc(even) = %d, want 2", n)
+	}
+	checkListPointers(t, l, []*Element[int]{e1, e3})
+	if n := l.DeleteFunc(func(int) bool { return true }); n != 2 {
+		t.Errorf("l.DeleteFunc(all) = %d, want 2", n)
+	}
+	checkListPointers(t, l, []*Element[int]{})
+
+	var z List[int]
+	if n := z.DeleteFunc(func(int) bool { return true }); n != 0 {
+		t.Errorf("DeleteFunc on empty list = %d, want 0", n)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	l := NewFromSlice([]int{1, 2, 3, 4, 5})
+	odd := l.Filter(func(v int) bool { return v%2 == 1 })
+	if !Equal(odd, NewFromSlice([]int{1, 3, 5})) {
+		t.Errorf("l.Filter(odd) = %v, want [1 3 5]", odd.ToSlice())
+	}
+	if !Equal(l, NewFromSlice([]int{1, 2, 3, 4, 5})) {
+		t.Errorf("l modified by Filter: %v", l.ToSlice())
+	}
+}
+
+func TestMap(t *testing.T) {
+	l := NewFromSlice([]int{1, 2, 3})
+	got := Map(l, func(v int) string { return strings.Repeat("x", v) })
+	if !Equal(got, NewFromSlice([]string{"x", "xx", "xxx"})) {
+		t.Errorf("Map = %v, want [x xx xxx]", got.ToSlice())
+	}
+	if got := Map(New[int](), func(v int) int { return v }); got.Len() != 0 {
+		t.Errorf("Map of empty list has length %d", got.Len())
+	}
+}
+
+func TestReduce(t *testing.T) {
+	l := NewFromSlice([]int{1, 2, 3, 4})
+	if sum := Reduce(l, 0, func(a, v int) int { return a + v }); sum != 10 {
+		t.Errorf("Reduce sum = %d, want 10", sum)
+	}
+	var b strings.Builder
+	Reduce(l, &b, func(b *strings.Builder, v int) *strings.Builder {
+		b.WriteByte(byte('0' + v))
+		return b
+	})
+	if got := b.String(); got != "1234" {
+		t.Errorf("Reduce join = %q, want %q", got, "1234")
+	}
+}
+
+func TestDo(t *testing.T) {
+	l := NewFromSlice([]int{1, 2, 3, 4})
+	var seen []int
+	l.Do(func(v int) bool {
+		seen = append(seen, v)
+		return v < 2
+	})
+	if !Equal(NewFromSlice(seen), NewFromSlice([]int{1, 2})) {
+		t.Errorf("Do visited %v, want [1 2]", seen)
+	}
+	n := 0
+	l.Do(func(int) bool { n++; return true })
+	if n != 4 {
+		t.Errorf("Do visited %d values, want 4", n)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	l.PushBack(1)
+	e2 := l.PushBack(2)
+	e3 := l.PushBack(3)
+	l.PushBack(3)
+	l.PushBack(3)
+	e4 := l.PushBack(1)
+	if n := Compact(l); n != 3 {
+		t.Errorf("Compact removed %d, want 3", n)
+	}
+	checkListPointers(t, l, []*Element[int]{e1, e2, e3, e4})
+
+	s := NewFromSlice([]string{"a", "A", "b", "B", "b"})
+	if n := s.CompactFunc(strings.EqualFold); n != 3 {
+		t.Errorf("CompactFunc removed %d, want 3", n)
+	}
+	if !Equal(s, NewFromSlice([]string{"a", "b"})) {
+		t.Errorf("CompactFunc = %v, want [a b]", s.ToSlice())
+	}
+
+	var z List[int]
+	if n := Compact(&z); n != 0 {
+		t.Errorf("Compact on empty list = %d, want 0", n)
+	}
+}
+
+func TestDedup(t *testing.T) {
+	l := New[string]()
+	a := l.PushBack("a")
+	b := l.PushBack("b")
+	l.PushBack("a")
+	c := l.PushBack("c")
+	l.PushBack("b")
+	l.PushBack("a")
+	if n := Dedup(l); n != 3 {
+		t.Errorf("Dedup removed %d, want 3", n)
+	}
+	checkListPointers(t, l, []*Element[string]{a, b, c})
+}
+
+func TestCount(t *testing.T) {
+	l := NewFromSlice([]int{1, 2, 1, 3, 1})
+	if n := Count(l, 1); n != 3 {
+		t.Errorf("Count(l, 1) = %d, want 3", n)
+	}
+	if n := Count(l, 4); n != 0 {
+		t.Errorf("Count(l, 4) = %d, want 0", n)
+	}
+	if n := l.CountFunc(func(v int) bool { return v > 1 }); n != 2 {
+		t.Errorf("l.CountFunc(v > 1) = %d, want 2", n)
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	pos := func(v int) bool { return v > 0 }
+	tests := []struct {
+		vals           []int
+		any, all, none bool
+	}{
+		{nil, false, true, true},
+		{[]int{1, 2}, true, true, false},
+		{[]int{-1, 2}, true, false, false},
+		{[]int{-1, -2}, false, false, true},
+	}
+	for _, tt := range tests {
+		l := NewFromSlice(tt.vals)
+		if got := l.AnyFunc(pos); got != tt.any {
+			t.Errorf("%v.AnyFunc(pos) = %v, want %v", tt.vals, got, tt.any)
+		}
+		if got := l.AllFunc(pos); got != tt.all {
+			t.Errorf("%v.AllFunc(pos) = %v, want %v", tt.vals, got, tt.all)
+		}
+		if got := l.NoneFunc(pos); got != tt.none {
+			t.Errorf("%v.NoneFunc(pos) = %v, want %v", tt.vals, got, tt.none)
+		}
+	}
+}
+
+func TestZip(t *testing.T) {
+	a := NewFromSlice([]int{1, 2, 3})
+	b := NewFromSlice([]string{"a", "b"})
+	got := Zip(a, b)
+	want := NewFromSlice([]Pair[int, string]{{1, "a"}, {2, "b"}})
+	if !Equal(got, want) {
+		t.Errorf("Zip = %v, want %v", got.ToSlice(), want.ToSlice())
+	}
+	if z := Zip(a, New[string]()); z.Len() != 0 {
+		t.Errorf("Zip with empty list has length %d", z.Len())
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	a := NewFromSlice([]int{1, 4, 6, 7})
+	b := NewFromSlice([]int{2})
+	c := NewFromSlice([]int{3, 5})
+	got := Interleave(a, b, New[int](), c)
+	if want := NewFromSlice([]int{1, 2, 3, 4, 5, 6, 7}); !Equal(got, want) {
+		t.Errorf("Interleave = %v, want %v", got.ToSlice(), want.ToSlice())
+	}
+	if got := Interleave[int](); got.Len() != 0 {
+		t.Errorf("Interleave() has length %d", got.Len())
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	l := NewFromSlice([]string{"apple", "bob", "avocado", "cat", "banana"})
+	groups := GroupBy(l, func(s string) byte { return s[0] })
+	want := map[byte][]string{
+		'a': {"apple", "avocado"},
+		'b': {"bob", "banana"},
+		'c': {"cat"},
+	}
+	if len(groups) != len(want) {
+		t.Errorf("GroupBy produced %d groups, want %d", len(groups), len(want))
+	}
+	for k, w := range want {
+		g := groups[k]
+		if g == nil {
+			t.Errorf("group %q missing", k)
+		} else if !Equal(g, NewFromSlice(w)) {
+			t.Errorf("group %q = %v, want %v", k, g.ToSlice(), w)
+		}
+	}
+}
+
+func TestMinMaxFunc(t *testing.T) {
+	l := New[int]()
+	l.PushBack(3)
+	min1 := l.PushBack(1)
+	max1 := l.PushBack(4)
+	l.PushBack(1)
+	l.PushBack(4)
+	if e := l.MinFunc(cmp.Compare[int]); e != min1 {
+		t.Errorf("l.MinFunc is not the first minimal element")
+	}
+	if e := l.MaxFunc(cmp.Compare[int]); e != max1 {
+		t.Errorf("l.MaxFunc is not the first maximal element")
+	}
+	var z List[int]
+	if z.MinFunc(cmp.Compare[int]) != nil || z.MaxFunc(cmp.Compare[int]) != nil {
+		t.Errorf("MinFunc/MaxFunc on empty list not nil")
+	}
+}
@@ -0,0 +1,96 @@
+package list
+
+import "errors"
+
+// ErrStaleHandle is returned when a handle is used after its element has
+// been removed from the list.
+var ErrStaleHandle = errors.New("list: stale handle")
+
+// Handle refers to an element of a list, like an element pointer, but
+// detects use after removal. Removed elements are pooled and reused, so a
+// stale *Element may silently refer to another value; a stale Handle
+// instead makes the operations taking it return ErrStaleHandle. The zero
+// Handle is always stale.
+type Handle[E any] struct {
+	e   *Element[E]
+	gen uint64
+}
+
+// Handle returns a handle to element e of list l. If e is not an element
+// of l, the handle is stale.
+//
+// Once a handle has been taken from l, or elements have been moved into l
+// from such a list, Init unlinks the elements of l one by one so that
+// their handles become stale, making it O(n).
+//
+// Handle panics if l was created by NewSyncPooled: its removed elements
+// may be reused by lists on other goroutines, so checking a stale handle
+// would race with them.
+func (l *List[E]) Handle(e *Element[E]) Handle[E] {
+	if _, ok := l.alloc.(syncPool[E]); ok {
+		panic("list: handles are not supported on lists from NewSyncPooled")
+	}
+	if e == nil || e.list != l {
+		return Handle[E]{}
+	}
+	l.handles = true
+	return Handle[E]{e, e.gen}
+}
+
+// PushFrontHandle inserts a new element with value v at the front of list
+// l and returns a handle to it.
+func (l *List[E]) PushFrontHandle(v E) Handle[E] { return l.Handle(l.PushFront(v)) }
+
+// PushBackHandle inserts a new element with value v at the back of list l
+// and returns a handle to it.
+func (l *List[E]) PushBackHandle(v E) Handle[E] { return l.Handle(l.PushBack(v)) }
+
+// Resolve returns the element h refers to, or ErrStaleHandle if it has
+// been removed from l.
+func (l *List[E]) Resolve(h Handle[E]) (*Element[E], error) {
+	if h.e == nil || h.e.list != l || h.e.gen != h.gen {
+		return nil, ErrStaleHandle
+	}
+	return h.e, nil
+}
+
+// Get returns the value of the element h refers to.
+func (l *List[E]) Get(h Handle[E]) (E, error) {
+	e, err := l.Resolve(h)
+	if err != nil {
+		var zero E
+		return zero, err
+	}
+	return e.Value, nil
+}
+
+// Set sets the value of the element h refers to.
+func (l *List[E]) Set(h Handle[E], v E) error {
+	e, err := l.Resolve(h)
+	if err != nil {
+		return err
+	}
+	e.Value = v
+	return nil
+}
+
+// Delete removes the element h refers to from l and returns its value.
+// The handle, and any copies of it, become stale.
+func (l *List[E]) Delete(h Handle[E]) (E, error) {
+	e, err := l.Resolve(h)
+	if err != nil {
+		var zero E
+		return zero, err
+	}
+	return l.Remove(e), nil
+}
+
+// unlinkAll detaches every element of l, invalidating their handles.
+func (l *List[E]) unlinkAll() {
+	for e := l.root.next; e != &l.root; {
+		next := e.next
+		e.next, e.prev, e.list = nil, nil, nil
+		e.gen++
+		e = next
+	}
+}
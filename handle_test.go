@@ -0,0 +1,79 @@
+package list
+
+import (
+	"cmp"
+	"errors"
+	"testing"
+)
+
+func TestHandle(t *testing.T) {
+	l := New[int]()
+	a := l.PushBackHandle(1)
+	b := l.PushFrontHandle(2)
+	if v, err := l.Get(a); err != nil || v != 1 {
+		t.Errorf("Get(a) = %d, %v, want 1", v, err)
+	}
+	if err := l.Set(b, 3); err != nil {
+		t.Errorf("Set(b): %v", err)
+	}
+	if v, err := l.Delete(b); err != nil || v != 3 {
+		t.Errorf("Delete(b) = %d, %v, want 3", v, err)
+	}
+
+	// The pooled element of b is reused; b must not see the new value.
+	c := l.PushBackHandle(4)
+	if cb, _ := l.Resolve(c); cb != b.e {
+		t.Fatalf("removed element was not reused")
+	}
+	if _, err := l.Get(b); !errors.Is(err, ErrStaleHandle) {
+		t.Errorf("Get of removed handle = %v, want ErrStaleHandle", err)
+	}
+	if err := l.Set(b, 5); !errors.Is(err, ErrStaleHandle) {
+		t.Errorf("Set of removed handle = %v, want ErrStaleHandle", err)
+	}
+	if _, err := l.Delete(b); !errors.Is(err, ErrStaleHandle) || l.Len() != 2 {
+		t.Errorf("Delete of removed handle = %v with length %d, want ErrStaleHandle and 2", err, l.Len())
+	}
+	if _, err := l.Resolve(Handle[int]{}); !errors.Is(err, ErrStaleHandle) {
+		t.Errorf("Resolve of zero handle = %v, want ErrStaleHandle", err)
+	}
+	if _, err := New[int]().Resolve(a); !errors.Is(err, ErrStaleHandle) {
+		t.Errorf("Resolve in another list = %v, want ErrStaleHandle", err)
+	}
+
+	l.Init()
+	if _, err := l.Get(a); !errors.Is(err, ErrStaleHandle) {
+		t.Errorf("Get after Init = %v, want ErrStaleHandle", err)
+	}
+	if v, err := l.Get(l.PushBackHandle(6)); err != nil || v != 6 {
+		t.Errorf("Get after Init and push = %d, %v, want 6", v, err)
+	}
+}
+
+func TestHandleSyncPooled(t *testing.T) {
+	l := NewSyncPooled[int]()
+	e := l.PushBack(1)
+	mustPanic(t, "Handle on a NewSyncPooled list", func() { l.Handle(e) })
+}
+
+func TestHandleMovedInit(t *testing.T) {
+	l := NewFromSlice([]int{1, 2, 3})
+	h := l.Handle(l.At(1))
+	r := l.SplitBefore(l.At(1))
+	r.Init()
+	if _, err := r.Get(h); !errors.Is(err, ErrStaleHandle) {
+		t.Errorf("Get after SplitBefore and Init = %v, want ErrStaleHandle", err)
+	}
+
+	l = NewFromSlice([]int{1, 3})
+	other := NewFromSlice([]int{2})
+	h = other.Handle(other.Front())
+	l.MergeFunc(other, cmp.Compare[int])
+	l.Init()
+	if _, err := l.Delete(h); !errors.Is(err, ErrStaleHandle) {
+		t.Errorf("Delete after MergeFunc and Init = %v, want ErrStaleHandle", err)
+	}
+	if err := l.CheckInvariants(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,14 @@
+package list
+
+import "testing"
+
+// mustPanic reports an error if f returns without panicking.
+func mustPanic(t *testing.T, name string, f func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("%s did not panic", name)
+		}
+	}()
+	f()
+}
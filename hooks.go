@@ -0,0 +1,139 @@
+package list
+
+// listHooks observes changes to a list on behalf of the callbacks set by
+// OnInsert, OnRemove and OnMove, the journal enabled by SetJournal and the
+// transaction started by Begin. A list without any of them has nil hooks.
+type listHooks[E any] struct {
+	onInsert, onRemove, onMove func(e *Element[E])
+
+	journal  bool
+	changes  []Change[E]
+	txn      *Txn[E]
+	moveFrom int // index of the range being moved, recorded by moving
+	before   []E // contents before a rearrangement, recorded by reordering
+}
+
+// OnInsert registers f to be called with each element inserted into list
+// l, after it has been linked, or removes the callback if f is nil.
+// Elements spliced in from another list are reported too.
+//
+// Callbacks registered with OnInsert, OnRemove and OnMove let dependent
+// structures such as indexes stay in sync with l. They run during the
+// operation and must not modify l. Reordering operations such as Sort,
+// Reverse and Rotate are not reported.
+func (l *List[E]) OnInsert(f func(e *Element[E])) {
+	l.hookFuncs().onInsert = f
+	l.dropHooks()
+}
+
+// OnRemove registers f to be called with each element removed from list
+// l, before it is unlinked, or removes the callback if f is nil. Elements
+// spliced out to another list and elements dropped by Init are reported
+// too.
+func (l *List[E]) OnRemove(f func(e *Element[E])) {
+	l.hookFuncs().onRemove = f
+	l.dropHooks()
+}
+
+// OnMove registers f to be called with each element moved to a new
+// position within list l, after the move, or removes the callback if f is
+// nil.
+func (l *List[E]) OnMove(f func(e *Element[E])) {
+	l.hookFuncs().onMove = f
+	l.dropHooks()
+}
+
+func (l *List[E]) hookFuncs() *listHooks[E] {
+	if l.hooks == nil {
+		l.hooks = new(listHooks[E])
+	}
+	return l.hooks
+}
+
+// dropHooks releases the hooks once nothing observes l, so that
+// unobserved lists pay only a nil check.
+func (l *List[E]) dropHooks() {
+	h := l.hooks
+	if h.onInsert == nil && h.onRemove == nil && h.onMove == nil && !h.journal && len(h.changes) == 0 && h.txn == nil {
+		l.hooks = nil
+	}
+}
+
+// notify calls f, if set, with each element from first to last inclusive.
+func notify[E any](f func(*Element[E]), first, last *Element[E]) {
+	if f == nil {
+		return
+	}
+	for e := first; ; e = e.next {
+		f(e)
+		if e == last {
+			return
+		}
+	}
+}
+
+// inserted reports that the range from first to last was linked into l.
+func (h *listHooks[E]) inserted(l *List[E], first, last *Element[E]) {
+	notify(h.onInsert, first, last)
+	if h.recording() {
+		h.record(ChangeInsert, l.position(first), 0, first, last)
+	}
+}
+
+// removing reports that the range from first to last is about to be
+// unlinked from l.
+func (h *listHooks[E]) removing(l *List[E], first, last *Element[E]) {
+	notify(h.onRemove, first, last)
+	if h.recording() {
+		h.record(ChangeRemove, l.position(first), 0, first, last)
+	}
+}
+
+// moving reports that the range starting at first is about to be moved
+// within l.
+func (h *listHooks[E]) moving(l *List[E], first *Element[E]) {
+	if h.recording() {
+		h.moveFrom = l.position(first)
+	}
+}
+
+// moved reports that the range from first to last was moved within l.
+func (h *listHooks[E]) moved(l *List[E], first, last *Element[E]) {
+	notify(h.onMove, first, last)
+	if h.recording() {
+		h.record(ChangeMove, l.position(first), h.moveFrom, first, last)
+	}
+}
+
+// reordering reports that the contents of l are about to be rearranged
+// wholesale.
+func (h *listHooks[E]) reordering(l *List[E]) {
+	if h.txn != nil {
+		h.before = l.AppendTo(nil)
+	}
+}
+
+// reordered reports that the contents of l were rearranged wholesale.
+func (h *listHooks[E]) reordered(l *List[E]) {
+	if h.recording() && l.root.next != &l.root {
+		h.record(ChangeReset, 0, 0, l.root.next, l.root.prev)
+	}
+	h.before = nil
+}
+
+// position returns the index of element e of l, walking from both ends at
+// once so that changes near either end are recorded in O(1) time.
+func (l *List[E]) position(e *Element[E]) int {
+	f, b := l.root.next, l.root.prev
+	for i := 0; ; i++ {
+		if f == e {
+			return i
+		}
+		if b == e {
+			return l.len - 1 - i
+		}
+		f, b = f.next, b.prev
+	}
+}
+
+func (h *listHooks[E]) recording() bool { return h.journal || h.txn != nil }
@@ -0,0 +1,79 @@
+package list
+
+import (
+	"cmp"
+	"testing"
+)
+
+// tracker mirrors the elements of a list through its hooks.
+type tracker struct {
+	elems map[*Element[int]]bool
+	moves int
+}
+
+func track(l *List[int]) *tracker {
+	tr := &tracker{elems: make(map[*Element[int]]bool)}
+	l.OnInsert(func(e *Element[int]) {
+		if e.List() != l {
+			panic("OnInsert called before the element was linked")
+		}
+		tr.elems[e] = true
+	})
+	l.OnRemove(func(e *Element[int]) {
+		if e.List() != l {
+			panic("OnRemove called after the element was unlinked")
+		}
+		delete(tr.elems, e)
+	})
+	l.OnMove(func(*Element[int]) { tr.moves++ })
+	return tr
+}
+
+func (tr *tracker) check(t *testing.T, name string, l *List[int]) {
+	t.Helper()
+	if len(tr.elems) != l.Len() {
+		t.Errorf("%s: tracking %d elements, list has %d", name, len(tr.elems), l.Len())
+	}
+	for e := range l.Elements() {
+		if !tr.elems[e] {
+			t.Errorf("%s: element %d not tracked", name, e.Value)
+		}
+	}
+}
+
+func TestHooks(t *testing.T) {
+	a, b := New[int](), New[int]()
+	ta, tb := track(a), track(b)
+
+	a.PushBackMany(5, 1, 4)
+	b.PushBackMany(2, 3)
+	a.MoveToFront(a.Back())
+	a.Remove(a.Front())
+	a.MoveRangeBefore(a.Back(), a.Back(), a.Front())
+	ta.check(t, "a", a)
+	if ta.moves != 2 {
+		t.Errorf("a reported %d moves, want 2", ta.moves)
+	}
+
+	a.AdoptBack(b.Front())
+	rest := a.SplitBefore(a.Back())
+	ta.check(t, "a after splice", a)
+	tb.check(t, "b after splice", b)
+
+	a.MergeFunc(b, cmp.Compare[int])
+	ta.check(t, "a after merge", a)
+	tb.check(t, "b after merge", b)
+
+	a.Init()
+	ta.check(t, "a after Init", a)
+	if rest.Len() != 1 {
+		t.Errorf("rest.Len() = %d, want 1", rest.Len())
+	}
+
+	a.OnInsert(nil)
+	a.OnRemove(nil)
+	a.OnMove(nil)
+	if a.hooks != nil {
+		t.Errorf("hooks kept after clearing every callback")
+	}
+}
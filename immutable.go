@@ -0,0 +1,208 @@
+package list
+
+import "iter"
+
+// ImmutableList is a persistent list. Operations that modify it return a
+// new version and leave the original unchanged; versions share all nodes
+// not on the path of the change, so each costs O(log n) time and space.
+// Because a version never changes once created, any number of goroutines
+// may read it without locking, and earlier versions can be kept cheaply.
+// Values are addressed by index. The zero value is an empty list ready to
+// use.
+type ImmutableList[E any] struct {
+	root *immNode[E]
+}
+
+// immNode is a node of a height balanced (AVL) tree ordered by position.
+// Nodes are never modified once they are reachable from a list.
+type immNode[E any] struct {
+	left, right *immNode[E]
+	value       E
+	size        int
+	height      int
+}
+
+func (n *immNode[E]) len() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *immNode[E]) h() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// newImmNode returns a node joining left, v and right, whose heights must
+// differ by at most one.
+func newImmNode[E any](left *immNode[E], v E, right *immNode[E]) *immNode[E] {
+	return &immNode[E]{
+		left:   left,
+		right:  right,
+		value:  v,
+		size:   left.len() + 1 + right.len(),
+		height: max(left.h(), right.h()) + 1,
+	}
+}
+
+// immBalance is like newImmNode but allows the heights of left and right to
+// differ by two, rotating to restore the balance.
+func immBalance[E any](left *immNode[E], v E, right *immNode[E]) *immNode[E] {
+	switch {
+	case left.h() > right.h()+1:
+		if left.left.h() < left.right.h() {
+			lr := left.right
+			return newImmNode(newImmNode(left.left, left.value, lr.left), lr.value, newImmNode(lr.right, v, right))
+		}
+		return newImmNode(left.left, left.value, newImmNode(left.right, v, right))
+	case right.h() > left.h()+1:
+		if right.right.h() < right.left.h() {
+			rl := right.left
+			return newImmNode(newImmNode(left, v, rl.left), rl.value, newImmNode(rl.right, right.value, right.right))
+		}
+		return newImmNode(newImmNode(left, v, right.left), right.value, right.right)
+	}
+	return newImmNode(left, v, right)
+}
+
+func (n *immNode[E]) insert(i int, v E) *immNode[E] {
+	if n == nil {
+		return &immNode[E]{value: v, size: 1, height: 1}
+	}
+	k := n.left.len()
+	if i <= k {
+		return immBalance(n.left.insert(i, v), n.value, n.right)
+	}
+	return immBalance(n.left, n.value, n.right.insert(i-k-1, v))
+}
+
+func (n *immNode[E]) remove(i int) *immNode[E] {
+	switch k := n.left.len(); {
+	case i < k:
+		return immBalance(n.left.remove(i), n.value, n.right)
+	case i > k:
+		return immBalance(n.left, n.value, n.right.remove(i-k-1))
+	case n.left == nil:
+		return n.right
+	case n.right == nil:
+		return n.left
+	}
+	// Replace n by its successor.
+	return immBalance(n.left, n.right.at(0), n.right.remove(0))
+}
+
+func (n *immNode[E]) set(i int, v E) *immNode[E] {
+	switch k := n.left.len(); {
+	case i < k:
+		return newImmNode(n.left.set(i, v), n.value, n.right)
+	case i > k:
+		return newImmNode(n.left, n.value, n.right.set(i-k-1, v))
+	}
+	return newImmNode(n.left, v, n.right)
+}
+
+func (n *immNode[E]) at(i int) E {
+	for {
+		switch k := n.left.len(); {
+		case i < k:
+			n = n.left
+		case i > k:
+			i -= k + 1
+			n = n.right
+		default:
+			return n.value
+		}
+	}
+}
+
+// Len returns the number of values in l.
+func (l ImmutableList[E]) Len() int { return l.root.len() }
+
+// At returns the value at index i of l, or ok == false if i is out of
+// range.
+func (l ImmutableList[E]) At(i int) (v E, ok bool) {
+	if i < 0 || i >= l.Len() {
+		return v, false
+	}
+	return l.root.at(i), true
+}
+
+// Front returns the first value of l, or ok == false if l is empty.
+func (l ImmutableList[E]) Front() (v E, ok bool) { return l.At(0) }
+
+// Back returns the last value of l, or ok == false if l is empty.
+func (l ImmutableList[E]) Back() (v E, ok bool) { return l.At(l.Len() - 1) }
+
+// PushFront returns a list with v inserted at the front of l.
+func (l ImmutableList[E]) PushFront(v E) ImmutableList[E] {
+	return ImmutableList[E]{l.root.insert(0, v)}
+}
+
+// PushBack returns a list with v inserted at the back of l.
+func (l ImmutableList[E]) PushBack(v E) ImmutableList[E] {
+	return ImmutableList[E]{l.root.insert(l.Len(), v)}
+}
+
+// Insert returns a list with v inserted at index i of l, shifting later
+// values up. If i is not in [0, l.Len()], Insert returns l.
+func (l ImmutableList[E]) Insert(i int, v E) ImmutableList[E] {
+	if i < 0 || i > l.Len() {
+		return l
+	}
+	return ImmutableList[E]{l.root.insert(i, v)}
+}
+
+// Set returns a list with the value at index i of l replaced by v. If i is
+// out of range, Set returns l.
+func (l ImmutableList[E]) Set(i int, v E) ImmutableList[E] {
+	if i < 0 || i >= l.Len() {
+		return l
+	}
+	return ImmutableList[E]{l.root.set(i, v)}
+}
+
+// Remove returns a list with the value at index i of l removed, shifting
+// later values down. If i is out of range, Remove returns l.
+func (l ImmutableList[E]) Remove(i int) ImmutableList[E] {
+	if i < 0 || i >= l.Len() {
+		return l
+	}
+	return ImmutableList[E]{l.root.remove(i)}
+}
+
+// All returns an iterator over the values of l from front to back.
+func (l ImmutableList[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		var stack []*immNode[E]
+		for n := l.root; n != nil || len(stack) > 0; n = n.right {
+			for ; n != nil; n = n.left {
+				stack = append(stack, n)
+			}
+			n = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the values of l from back to front.
+func (l ImmutableList[E]) Backward() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		var stack []*immNode[E]
+		for n := l.root; n != nil || len(stack) > 0; n = n.left {
+			for ; n != nil; n = n.right {
+				stack = append(stack, n)
+			}
+			n = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
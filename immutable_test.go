@@ -0,0 +1,100 @@
+package list
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// checkImm verifies the sizes and balance of the tree under n and returns
+// its height.
+func checkImm[E any](t *testing.T, n *immNode[E]) int {
+	t.Helper()
+	if n == nil {
+		return 0
+	}
+	lh, rh := checkImm(t, n.left), checkImm(t, n.right)
+	if d := lh - rh; d < -1 || d > 1 {
+		t.Fatalf("unbalanced node: heights %d and %d", lh, rh)
+	}
+	if n.size != n.left.len()+1+n.right.len() || n.height != max(lh, rh)+1 {
+		t.Fatalf("node size %d height %d is stale", n.size, n.height)
+	}
+	return n.height
+}
+
+func TestImmutableList(t *testing.T) {
+	r := rand.New(rand.NewPCG(13, 14))
+	var l ImmutableList[int]
+	var want []int
+	type version struct {
+		l    ImmutableList[int]
+		want []int
+	}
+	var versions []version
+	for i := range 2000 {
+		switch j := r.IntN(len(want) + 1); r.IntN(5) {
+		case 0:
+			l = l.PushFront(j)
+			want = slices.Insert(want, 0, j)
+		case 1:
+			l = l.PushBack(j)
+			want = append(want, j)
+		case 2:
+			l = l.Insert(j, -j)
+			want = slices.Insert(want, j, -j)
+		case 3:
+			if j < len(want) {
+				l = l.Set(j, 1000+j)
+				want[j] = 1000 + j
+			}
+		case 4:
+			if j < len(want) {
+				l = l.Remove(j)
+				want = slices.Delete(want, j, j+1)
+			}
+		}
+		if i%100 == 0 {
+			versions = append(versions, version{l, slices.Clone(want)})
+		}
+	}
+	checkImm(t, l.root)
+	if got := slices.Collect(l.All()); !slices.Equal(got, want) {
+		t.Fatalf("All = %v, want %v", got, want)
+	}
+	for _, v := range versions {
+		if got := slices.Collect(v.l.All()); !slices.Equal(got, v.want) {
+			t.Errorf("earlier version changed: got %v, want %v", got, v.want)
+		}
+	}
+	back := slices.Collect(l.Backward())
+	slices.Reverse(back)
+	if !slices.Equal(back, want) {
+		t.Errorf("Backward reversed = %v, want %v", back, want)
+	}
+	for i, w := range want {
+		if v, ok := l.At(i); !ok || v != w {
+			t.Fatalf("At(%d) = %d, %v, want %d", i, v, ok, w)
+		}
+	}
+}
+
+func TestImmutableListBounds(t *testing.T) {
+	var l ImmutableList[int]
+	if _, ok := l.Front(); ok {
+		t.Errorf("Front of empty list reported ok")
+	}
+	if _, ok := l.Back(); ok {
+		t.Errorf("Back of empty list reported ok")
+	}
+	l = l.PushBack(1).PushBack(2)
+	if l.Insert(3, 0).Len() != 2 || l.Remove(-1).Len() != 2 || l.Remove(2).Len() != 2 {
+		t.Errorf("out of range index modified the list")
+	}
+	if v, _ := l.Set(5, 9).Back(); v != 2 {
+		t.Errorf("out of range Set modified the list")
+	}
+	if v, _ := l.Front(); v != 1 {
+		t.Errorf("Front = %d, want 1", v)
+	}
+}
@@ -0,0 +1,295 @@
+package list
+
+import (
+	"iter"
+	"math/bits"
+	"math/rand/v2"
+)
+
+// IndexedList is a list with a skip-list index layered over its elements,
+// so that positional access with At, IndexOf and SplitAt takes O(log n)
+// expected time instead of O(n). Its elements are ordinary list elements:
+// Next, Prev and Value work as usual.
+//
+// Elements returned by an IndexedList belong to an internal List. Callers
+// may traverse them and modify their Value but must not move or detach
+// them, as that would desynchronise the index.
+// An IndexedList must be created with NewIndexedList.
+type IndexedList[E any] struct {
+	list List[E]
+	idx  skipIndex[E]
+}
+
+// NewIndexedList returns an empty indexed list.
+func NewIndexedList[E any]() *IndexedList[E] {
+	l := new(IndexedList[E])
+	l.list.Init()
+	l.idx.init()
+	return l
+}
+
+// Len returns the number of elements of l.
+func (l *IndexedList[E]) Len() int { return l.list.Len() }
+
+// Front returns the first element of l or nil if l is empty.
+func (l *IndexedList[E]) Front() *Element[E] { return l.list.Front() }
+
+// Back returns the last element of l or nil if l is empty.
+func (l *IndexedList[E]) Back() *Element[E] { return l.list.Back() }
+
+// insertAt inserts a new element with value v at index i, just after
+// element at, and returns it.
+func (l *IndexedList[E]) insertAt(v E, i int, at *Element[E]) *Element[E] {
+	var p skipPath[E]
+	l.idx.search(&p, func(_ *skipNode[E], rank int) bool { return rank < i })
+	e := l.list.insertValue(v, at)
+	l.idx.insert(&p, e)
+	return e
+}
+
+// PushFront inserts a new element with value v at the front of l and
+// returns it.
+func (l *IndexedList[E]) PushFront(v E) *Element[E] {
+	return l.insertAt(v, 0, &l.list.root)
+}
+
+// PushBack inserts a new element with value v at the back of l and returns
+// it.
+func (l *IndexedList[E]) PushBack(v E) *Element[E] {
+	return l.insertAt(v, l.list.Len(), l.list.root.prev)
+}
+
+// InsertBefore inserts a new element with value v immediately before mark
+// and returns it. If mark is not an element of l, l is not modified and
+// InsertBefore returns nil. The mark must not be nil.
+func (l *IndexedList[E]) InsertBefore(v E, mark *Element[E]) *Element[E] {
+	if mark.list != &l.list {
+		return nil
+	}
+	return l.insertAt(v, l.IndexOf(mark), mark.prev)
+}
+
+// InsertAfter inserts a new element with value v immediately after mark
+// and returns it. If mark is not an element of l, l is not modified and
+// InsertAfter returns nil. The mark must not be nil.
+func (l *IndexedList[E]) InsertAfter(v E, mark *Element[E]) *Element[E] {
+	if mark.list != &l.list {
+		return nil
+	}
+	return l.insertAt(v, l.IndexOf(mark)+1, mark)
+}
+
+// Remove removes e from l if e is an element of l and returns e.Value.
+// The element must not be nil.
+func (l *IndexedList[E]) Remove(e *Element[E]) E {
+	if e.list == &l.list {
+		l.idx.remove(e)
+	}
+	return l.list.Remove(e)
+}
+
+// At returns the element at index i of l, or nil if i is out of range.
+func (l *IndexedList[E]) At(i int) *Element[E] {
+	if i < 0 || i >= l.list.Len() {
+		return nil
+	}
+	return l.idx.at(i).elem
+}
+
+// IndexOf returns the index of e in l, or -1 if e is not an element of l.
+func (l *IndexedList[E]) IndexOf(e *Element[E]) int {
+	if e == nil || e.list != &l.list {
+		return -1
+	}
+	return l.idx.rank(l.idx.nodes[e])
+}
+
+// SplitAt removes the elements of l from index i onwards and returns them
+// as a new indexed list, or returns nil if i is not in the range
+// [0, l.Len()]. Locating the split point and dividing the index take
+// O(log n) time; handing the moved elements over to the new list is
+// O(k) in their number, as with List.SplitBefore.
+func (l *IndexedList[E]) SplitAt(i int) *IndexedList[E] {
+	n := l.list.Len()
+	if i < 0 || i > n {
+		return nil
+	}
+	rest := NewIndexedList[E]()
+	if i == n {
+		return rest
+	}
+	var p skipPath[E]
+	l.idx.search(&p, func(_ *skipNode[E], rank int) bool { return rank < i })
+	first := p.node[0].links[0].next.elem
+	rest.list.spliceRange(&rest.list.root, first, l.list.root.prev, n-i, &l.list)
+	l.idx.split(&p, &rest.idx)
+	return rest
+}
+
+// All returns an iterator over the values of l from front to back.
+func (l *IndexedList[E]) All() iter.Seq[E] { return l.list.All() }
+
+// skipMaxLevel bounds the height of skip-list towers. With a branching
+// factor of 4 it comfortably covers any list that fits in memory.
+const skipMaxLevel = 24
+
+// skipIndex is an indexable skip list over the elements of a List. Each
+// link records its width, the number of list elements it spans, so that
+// ranks can be accumulated while searching.
+type skipIndex[E any] struct {
+	head  skipNode[E] // sentinel with rank -1 and skipMaxLevel links
+	level int         // number of levels in use
+	nodes map[*Element[E]]*skipNode[E]
+}
+
+type skipNode[E any] struct {
+	elem  *Element[E]
+	links []skipLink[E] // one per level of the tower
+}
+
+type skipLink[E any] struct {
+	next, prev *skipNode[E]
+	width      int // rank(next) - rank(node); unused when next is nil
+}
+
+// skipPath records, for each level, the last node a search visited there
+// and its rank.
+type skipPath[E any] struct {
+	node [skipMaxLevel]*skipNode[E]
+	rank [skipMaxLevel]int
+}
+
+func (x *skipIndex[E]) init() {
+	x.head.links = make([]skipLink[E], skipMaxLevel)
+	x.level = 1
+	x.nodes = make(map[*Element[E]]*skipNode[E])
+}
+
+// search descends the index from the head, moving right at each level for
+// as long as advance accepts the next node and its rank, and records the
+// path taken in p.
+func (x *skipIndex[E]) search(p *skipPath[E], advance func(n *skipNode[E], rank int) bool) {
+	n, r := &x.head, -1
+	for lvl := x.level - 1; lvl >= 0; lvl-- {
+		for {
+			link := &n.links[lvl]
+			if link.next == nil || !advance(link.next, r+link.width) {
+				break
+			}
+			n, r = link.next, r+link.width
+		}
+		p.node[lvl], p.rank[lvl] = n, r
+	}
+}
+
+// at returns the node of rank i, which must be in range.
+func (x *skipIndex[E]) at(i int) *skipNode[E] {
+	var p skipPath[E]
+	x.search(&p, func(_ *skipNode[E], rank int) bool { return rank <= i })
+	return p.node[0]
+}
+
+// rank returns the rank of n by walking back along the top link of each
+// tower, which retraces a search path in reverse.
+func (x *skipIndex[E]) rank(n *skipNode[E]) int {
+	r := -1
+	for n != &x.head {
+		top := len(n.links) - 1
+		prev := n.links[top].prev
+		r += prev.links[top].width
+		n = prev
+	}
+	return r
+}
+
+func skipHeight() int {
+	// Each extra level is kept with probability 1/4.
+	return min(bits.TrailingZeros64(rand.Uint64())/2+1, skipMaxLevel)
+}
+
+// insert adds a node for e directly after p.node[0], as found by search.
+func (x *skipIndex[E]) insert(p *skipPath[E], e *Element[E]) {
+	i := p.rank[0] + 1
+	h := skipHeight()
+	for ; x.level < h; x.level++ {
+		p.node[x.level], p.rank[x.level] = &x.head, -1
+	}
+	n := &skipNode[E]{elem: e, links: make([]skipLink[E], h)}
+	for lvl := 0; lvl < x.level; lvl++ {
+		u := &p.node[lvl].links[lvl]
+		if lvl >= h {
+			if u.next != nil {
+				u.width++
+			}
+			continue
+		}
+		link := &n.links[lvl]
+		link.prev = p.node[lvl]
+		if u.next != nil {
+			link.next = u.next
+			link.width = p.rank[lvl] + u.width + 1 - i
+			u.next.links[lvl].prev = n
+		}
+		u.next = n
+		u.width = i - p.rank[lvl]
+	}
+	x.nodes[e] = n
+}
+
+// remove unlinks the node for e from the index.
+func (x *skipIndex[E]) remove(e *Element[E]) {
+	n := x.nodes[e]
+	delete(x.nodes, e)
+	h := len(n.links)
+	for lvl := range h {
+		link := &n.links[lvl]
+		prev := &link.prev.links[lvl]
+		prev.next = link.next
+		if link.next != nil {
+			prev.width += link.width - 1
+			link.next.links[lvl].prev = link.prev
+		}
+	}
+	// Links above the tower that span n lose one element of width.
+	p := n.links[h-1].prev
+	for lvl := h; lvl < x.level; lvl++ {
+		for len(p.links) <= lvl {
+			p = p.links[len(p.links)-1].prev
+		}
+		if p.links[lvl].next != nil {
+			p.links[lvl].width--
+		}
+	}
+	x.shrink()
+}
+
+// split moves every node after p.node[0], as found by search, into the
+// empty index rest. The elements themselves must already have been moved.
+func (x *skipIndex[E]) split(p *skipPath[E], rest *skipIndex[E]) {
+	i := p.rank[0] + 1
+	for lvl := 0; lvl < x.level; lvl++ {
+		u := &p.node[lvl].links[lvl]
+		if u.next == nil {
+			continue
+		}
+		h := &rest.head.links[lvl]
+		h.next = u.next
+		h.width = p.rank[lvl] + u.width - i + 1
+		u.next.links[lvl].prev = &rest.head
+		u.next = nil
+	}
+	rest.level = x.level
+	for n := rest.head.links[0].next; n != nil; n = n.links[0].next {
+		delete(x.nodes, n.elem)
+		rest.nodes[n.elem] = n
+	}
+	x.shrink()
+	rest.shrink()
+}
+
+// shrink drops empty levels from the top of the index.
+func (x *skipIndex[E]) shrink() {
+	for x.level > 1 && x.head.links[x.level-1].next == nil {
+		x.level--
+	}
+}
@@ -0,0 +1,82 @@
+package list
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// checkIndexed verifies At and IndexOf for every position of l against
+// want.
+func checkIndexed(t *testing.T, l *IndexedList[int], want []int) {
+	t.Helper()
+	if got := slices.Collect(l.All()); !slices.Equal(got, want) {
+		t.Fatalf("l.All() = %v, want %v", got, want)
+	}
+	for i, v := range want {
+		e := l.At(i)
+		if e == nil || e.Value != v {
+			t.Fatalf("l.At(%d) = %v, want %d", i, e, v)
+		}
+		if got := l.IndexOf(e); got != i {
+			t.Fatalf("l.IndexOf(At(%d)) = %d", i, got)
+		}
+	}
+	if l.At(-1) != nil || l.At(len(want)) != nil {
+		t.Fatalf("At out of range returned an element")
+	}
+	if err := l.list.CheckInvariants(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIndexedList(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	l := NewIndexedList[int]()
+	var want []int
+	for v := range 2000 {
+		switch n := len(want); {
+		case n == 0 || r.IntN(4) == 0:
+			l.PushBack(v)
+			want = append(want, v)
+		case r.IntN(3) == 0:
+			l.PushFront(v)
+			want = slices.Insert(want, 0, v)
+		case r.IntN(2) == 0:
+			i := r.IntN(n)
+			l.InsertBefore(v, l.At(i))
+			want = slices.Insert(want, i, v)
+		default:
+			i := r.IntN(n)
+			l.InsertAfter(v, l.At(i))
+			want = slices.Insert(want, i+1, v)
+		}
+		if len(want) > 50 && r.IntN(2) == 0 {
+			i := r.IntN(len(want))
+			if got := l.Remove(l.At(i)); got != want[i] {
+				t.Fatalf("l.Remove(At(%d)) = %d, want %d", i, got, want[i])
+			}
+			want = slices.Delete(want, i, i+1)
+		}
+	}
+	checkIndexed(t, l, want)
+
+	rest := l.SplitAt(len(want) / 3)
+	checkIndexed(t, l, want[:len(want)/3])
+	checkIndexed(t, rest, want[len(want)/3:])
+	rest.PushFront(-1)
+	checkIndexed(t, rest, append([]int{-1}, want[len(want)/3:]...))
+
+	if l.SplitAt(-1) != nil || l.SplitAt(l.Len()+1) != nil {
+		t.Errorf("SplitAt out of range returned a list")
+	}
+	if l.SplitAt(l.Len()).Len() != 0 {
+		t.Errorf("SplitAt(Len) returned a non-empty list")
+	}
+	if all := l.SplitAt(0); l.Len() != 0 || all.Len() != len(want)/3 {
+		t.Errorf("SplitAt(0) left %d elements, moved %d", l.Len(), all.Len())
+	}
+	if l.IndexOf(rest.Front()) != -1 || l.InsertAfter(0, rest.Front()) != nil {
+		t.Errorf("foreign element accepted")
+	}
+}
@@ -0,0 +1,150 @@
+// Package intrusive implements doubly linked lists that link through a
+// Hook embedded in the listed values themselves. Inserting a value does
+// not allocate, and a value can be removed in O(1) given only a pointer to
+// it. A value may embed several hooks to be on several lists at once.
+//
+// To iterate over a list:
+//
+//	for t := l.Front(); t != nil; t = l.Next(t) {
+//		// do something with t
+//	}
+package intrusive
+
+import "iter"
+
+// Hook links a value into a List. Embed one Hook per list the value may
+// belong to. The zero value is an unlinked hook.
+type Hook[T any] struct {
+	next, prev *Hook[T]
+	list       *List[T]
+	owner      *T
+}
+
+// Linked reports whether the hook is linked into a list.
+func (h *Hook[T]) Linked() bool { return h.list != nil }
+
+// List is an intrusive doubly linked list of *T values, linked through
+// the Hook that its accessor function returns for each value.
+// A List must be created with New.
+type List[T any] struct {
+	root Hook[T] // sentinel, only root.next and root.prev are used
+	len  int
+	hook func(*T) *Hook[T]
+}
+
+// New returns an empty list linking values through the hook returned by
+// hook, which is typically the address of a field:
+//
+//	l := intrusive.New(func(t *Task) *intrusive.Hook[Task] { return &t.runq })
+func New[T any](hook func(*T) *Hook[T]) *List[T] {
+	l := &List[T]{hook: hook}
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	return l
+}
+
+// Len returns the number of values in l.
+func (l *List[T]) Len() int { return l.len }
+
+// Contains reports whether v is in l.
+func (l *List[T]) Contains(v *T) bool { return l.hook(v).list == l }
+
+func (l *List[T]) value(h *Hook[T]) *T {
+	if h == &l.root {
+		return nil
+	}
+	return h.owner
+}
+
+// Front returns the first value of l or nil if l is empty.
+func (l *List[T]) Front() *T { return l.value(l.root.next) }
+
+// Back returns the last value of l or nil if l is empty.
+func (l *List[T]) Back() *T { return l.value(l.root.prev) }
+
+// Next returns the value after v in l, or nil if v is the last value or
+// not in l.
+func (l *List[T]) Next(v *T) *T {
+	if h := l.hook(v); h.list == l {
+		return l.value(h.next)
+	}
+	return nil
+}
+
+// Prev returns the value before v in l, or nil if v is the first value or
+// not in l.
+func (l *List[T]) Prev(v *T) *T {
+	if h := l.hook(v); h.list == l {
+		return l.value(h.prev)
+	}
+	return nil
+}
+
+// insert links v after at. It panics if v is already in a list, since
+// relinking its hook would corrupt that list.
+func (l *List[T]) insert(v *T, at *Hook[T]) {
+	h := l.hook(v)
+	if h.list != nil {
+		panic("intrusive: value is already in a list")
+	}
+	h.prev = at
+	h.next = at.next
+	h.prev.next = h
+	h.next.prev = h
+	h.list = l
+	h.owner = v
+	l.len++
+}
+
+// PushFront inserts v at the front of l. It panics if v is already in a
+// list through the same hook.
+func (l *List[T]) PushFront(v *T) { l.insert(v, &l.root) }
+
+// PushBack inserts v at the back of l. It panics if v is already in a
+// list through the same hook.
+func (l *List[T]) PushBack(v *T) { l.insert(v, l.root.prev) }
+
+// InsertBefore inserts v immediately before mark. If mark is not in l, l
+// is not modified. It panics if v is already in a list through the same
+// hook.
+func (l *List[T]) InsertBefore(v, mark *T) {
+	if m := l.hook(mark); m.list == l {
+		l.insert(v, m.prev)
+	}
+}
+
+// InsertAfter inserts v immediately after mark. If mark is not in l, l is
+// not modified. It panics if v is already in a list through the same hook.
+func (l *List[T]) InsertAfter(v, mark *T) {
+	if m := l.hook(mark); m.list == l {
+		l.insert(v, m)
+	}
+}
+
+// Remove removes v from l and reports whether it was in l. Afterwards v
+// may be inserted into any list again.
+func (l *List[T]) Remove(v *T) bool {
+	h := l.hook(v)
+	if h.list != l {
+		return false
+	}
+	h.prev.next = h.next
+	h.next.prev = h.prev
+	*h = Hook[T]{}
+	l.len--
+	return true
+}
+
+// All returns an iterator over the values of l from front to back.
+// The value being visited may be removed during iteration.
+func (l *List[T]) All() iter.Seq[*T] {
+	return func(yield func(*T) bool) {
+		for h := l.root.next; h != &l.root; {
+			next := h.next
+			if !yield(h.owner) {
+				return
+			}
+			h = next
+		}
+	}
+}
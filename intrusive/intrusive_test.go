@@ -0,0 +1,78 @@
+package intrusive
+
+import (
+	"slices"
+	"testing"
+)
+
+type task struct {
+	id        int
+	runq, all Hook[task]
+}
+
+func ids(l *List[task]) []int {
+	var s []int
+	for t := range l.All() {
+		s = append(s, t.id)
+	}
+	return s
+}
+
+func TestList(t *testing.T) {
+	runq := New(func(t *task) *Hook[task] { return &t.runq })
+	all := New(func(t *task) *Hook[task] { return &t.all })
+	ts := make([]task, 4)
+	for i := range ts {
+		ts[i].id = i
+		runq.PushBack(&ts[i])
+		all.PushFront(&ts[i])
+	}
+	if got, want := ids(runq), []int{0, 1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("runq = %v, want %v", got, want)
+	}
+	if got, want := ids(all), []int{3, 2, 1, 0}; !slices.Equal(got, want) {
+		t.Errorf("all = %v, want %v", got, want)
+	}
+
+	if !runq.Remove(&ts[1]) || runq.Remove(&ts[1]) || runq.Contains(&ts[1]) || !all.Contains(&ts[1]) {
+		t.Errorf("Remove through one hook affected the other")
+	}
+	runq.InsertAfter(&ts[1], &ts[3])
+	if got, want := ids(runq), []int{0, 2, 3, 1}; !slices.Equal(got, want) {
+		t.Errorf("runq = %v, want %v", got, want)
+	}
+	if runq.Front() != &ts[0] || runq.Back() != &ts[1] || runq.Next(&ts[2]) != &ts[3] || runq.Prev(&ts[0]) != nil {
+		t.Errorf("Front, Back, Next or Prev wrong")
+	}
+
+	// Removing while iterating.
+	for v := range runq.All() {
+		runq.Remove(v)
+	}
+	if runq.Len() != 0 || runq.Front() != nil || ts[0].runq.Linked() {
+		t.Errorf("runq not empty after removing every value")
+	}
+}
+
+func TestListDoubleInsertPanics(t *testing.T) {
+	l := New(func(t *task) *Hook[task] { return &t.runq })
+	v := new(task)
+	l.PushBack(v)
+	defer func() {
+		if recover() == nil {
+			t.Errorf("second PushBack did not panic")
+		}
+	}()
+	l.PushBack(v)
+}
+
+func TestListNoAllocs(t *testing.T) {
+	l := New(func(t *task) *Hook[task] { return &t.runq })
+	v := new(task)
+	if n := testing.AllocsPerRun(100, func() {
+		l.PushBack(v)
+		l.Remove(v)
+	}); n != 0 {
+		t.Errorf("PushBack and Remove allocated %v times", n)
+	}
+}
@@ -0,0 +1,153 @@
+package list
+
+import "iter"
+
+// All returns an iterator over the values of list l from front to back.
+func (l *List[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		mod := l.mod
+		for e := l.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value) {
+				return
+			}
+			l.checkMod(mod)
+		}
+	}
+}
+
+// checkMod panics if l has been structurally modified since its
+// modification count was mod.
+func (l *List[E]) checkMod(mod uint64) {
+	if l.mod != mod {
+		panic("list: modified during iteration")
+	}
+}
+
+// Backward returns an iterator over the values of list l from back to front.
+func (l *List[E]) Backward() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		mod := l.mod
+		for e := l.Back(); e != nil; e = e.Prev() {
+			if !yield(e.Value) {
+				return
+			}
+			l.checkMod(mod)
+		}
+	}
+}
+
+// Elements returns an iterator over the elements of list l from front to back.
+// The element most recently yielded may be removed from l or moved within l
+// without disturbing the iteration. At most as many elements are yielded as
+// l held when the iteration began, so an element moved to the back is not
+// visited again. The iterator panics if other structural changes remove the
+// element it would visit next.
+func (l *List[E]) Elements() iter.Seq[*Element[E]] {
+	return func(yield func(*Element[E]) bool) {
+		mod := l.mod
+		var next *Element[E]
+		for e, n := l.Front(), l.len; e != nil && n > 0; e, n = next, n-1 {
+			next = e.Next()
+			var gen uint64
+			if next != nil {
+				gen = next.gen
+			}
+			if !yield(e) {
+				return
+			}
+			if l.mod != mod {
+				// A removed element may have been reused by an insertion,
+				// so check its generation as well as its list.
+				if next != nil && (next.list != l || next.gen != gen) {
+					panic("list: modified during iteration")
+				}
+				mod = l.mod
+			}
+		}
+	}
+}
+
+// Enumerate returns an iterator over index-value pairs of list l from front
+// to back, with the front element at index 0.
+func (l *List[E]) Enumerate() iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		mod := l.mod
+		i := 0
+		for e := l.Front(); e != nil; e = e.Next() {
+			if !yield(i, e.Value) {
+				return
+			}
+			l.checkMod(mod)
+			i++
+		}
+	}
+}
+
+// FromSeq returns a new list containing the values yielded by seq, in order.
+func FromSeq[E any](seq iter.Seq[E]) *List[E] {
+	l := New[E]()
+	l.PushBackSeq(seq)
+	return l
+}
+
+// PushBackSeq inserts the values yielded by seq at the back of list l, in order.
+// The sequence must not be an iterator over l itself.
+func (l *List[E]) PushBackSeq(seq iter.Seq[E]) {
+	l.lazyInit()
+	for v := range seq {
+		l.insertValue(v, l.root.prev)
+	}
+}
+
+// Chunk returns an iterator over consecutive groups of up to n values of
+// list l. Each group is yielded as a new list; all but the last hold exactly
+// n values. List l is not modified. Chunk panics if n is less than 1.
+func (l *List[E]) Chunk(n int) iter.Seq[*List[E]] {
+	if n < 1 {
+		panic("list: Chunk size n must be at least 1")
+	}
+	return func(yield func(*List[E]) bool) {
+		mod := l.mod
+		for e := l.Front(); e != nil; {
+			c := New[E]()
+			for ; e != nil && c.len < n; e = e.Next() {
+				c.insertValue(e.Value, c.root.prev)
+			}
+			if !yield(c) {
+				return
+			}
+			l.checkMod(mod)
+		}
+	}
+}
+
+// Windows returns an iterator over the overlapping windows of n consecutive
+// values of list l, from front to back. A list of length m yields m-n+1
+// windows, or none if m < n. The yielded slice is reused between iterations,
+// so callers must copy it to retain a window. Windows panics if n is less
+// than 1.
+func (l *List[E]) Windows(n int) iter.Seq[[]E] {
+	if n < 1 {
+		panic("list: Windows size n must be at least 1")
+	}
+	return func(yield func([]E) bool) {
+		if n > l.len {
+			return
+		}
+		mod := l.mod
+		buf := make([]E, 0, n)
+		for e := l.Front(); e != nil; e = e.Next() {
+			if len(buf) == n {
+				copy(buf, buf[1:])
+				buf = buf[:n-1]
+			}
+			buf = append(buf, e.Value)
+			if len(buf) == n {
+				if !yield(buf) {
+					return
+				}
+				l.checkMod(mod)
+			}
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package list
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	var l List[int]
+	if got := slices.Collect(l.All()); len(got) != 0 {
+		t.Errorf("slices.Collect(l.All()) = %v, want []", got)
+	}
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushBack(3)
+	if got, want := slices.Collect(l.All()), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("slices.Collect(l.All()) = %v, want %v", got, want)
+	}
+	for v := range l.All() {
+		if v == 2 {
+			break
+		}
+		if v != 1 {
+			t.Errorf("iteration continued past break, got %d", v)
+		}
+	}
+}
+
+func TestBackward(t *testing.T) {
+	var l List[int]
+	if got := slices.Collect(l.Backward()); len(got) != 0 {
+		t.Errorf("slices.Collect(l.Backward()) = %v, want []", got)
+	}
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushBack(3)
+	if got, want := slices.Collect(l.Backward()), []int{3, 2, 1}; !slices.Equal(got, want) {
+		t.Errorf("slices.Collect(l.Backward()) = %v, want %v", got, want)
+	}
+}
+
+func TestElements(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+	e3 := l.PushBack(3)
+	e4 := l.PushBack(4)
+
+	var seen []*Element[int]
+	for e := range l.Elements() {
+		seen = append(seen, e)
+	}
+	if want := []*Element[int]{e1, e2, e3, e4}; !slices.Equal(seen, want) {
+		t.Errorf("l.Elements() yielded %v, want %v", seen, want)
+	}
+
+	// Removing or moving the current element must not stop the iteration.
+	var vals []int
+	for e := range l.Elements() {
+		vals = append(vals, e.Value)
+		switch e.Value {
+		case 2:
+			l.Remove(e)
+		case 3:
+			l.MoveToFront(e)
+		}
+	}
+	if want := []int{1, 2, 3, 4}; !slices.Equal(vals, want) {
+		t.Errorf("values seen = %v, want %v", vals, want)
+	}
+	checkListPointers(t, l, []*Element[int]{e3, e1, e4})
+
+	// Moving each element to the back visits every element once.
+	vals = vals[:0]
+	for e := range l.Elements() {
+		vals = append(vals, e.Value)
+		l.MoveToBack(e)
+	}
+	if want := []int{3, 1, 4}; !slices.Equal(vals, want) {
+		t.Errorf("values seen moving to back = %v, want %v", vals, want)
+	}
+	checkListPointers(t, l, []*Element[int]{e3, e1, e4})
+
+	for e := range l.Elements() {
+		l.Remove(e)
+	}
+	checkListPointers(t, l, []*Element[int]{})
+}
+
+func TestEnumerate(t *testing.T) {
+	l := New[string]()
+	l.PushBack("a")
+	l.PushBack("b")
+	l.PushBack("c")
+	want := []string{"a", "b", "c"}
+	n := 0
+	for i, v := range l.Enumerate() {
+		if i != n {
+			t.Errorf("index = %d, want %d", i, n)
+		}
+		if v != want[i] {
+			t.Errorf("value at %d = %q, want %q", i, v, want[i])
+		}
+		n++
+	}
+	if n != len(want) {
+		t.Errorf("l.Enumerate() yielded %d pairs, want %d", n, len(want))
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	l := FromSeq(slices.Values([]int{1, 2, 3}))
+	if got, want := slices.Collect(l.All()), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("FromSeq = %v, want %v", got, want)
+	}
+	l.PushBackSeq(slices.Values([]int{4, 5}))
+	if got, want := slices.Collect(l.All()), []int{1, 2, 3, 4, 5}; !slices.Equal(got, want) {
+		t.Errorf("after PushBackSeq = %v, want %v", got, want)
+	}
+
+	var z List[int]
+	z.PushBackSeq(FromSeq(slices.Values([]int{7})).All())
+	checkListLen(t, &z, 1)
+}
+
+func TestChunk(t *testing.T) {
+	l := NewFromSlice([]int{1, 2, 3, 4, 5})
+	var got [][]int
+	for c := range l.Chunk(2) {
+		got = append(got, c.ToSlice())
+	}
+	want := [][]int{{1, 2}, {3, 4}, {5}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("l.Chunk(2) = %v, want %v", got, want)
+	}
+	for range New[int]().Chunk(3) {
+		t.Errorf("Chunk of empty list yielded a group")
+	}
+
+	mustPanic(t, "Chunk(0)", func() { l.Chunk(0) })
+}
+
+func TestWindows(t *testing.T) {
+	l := NewFromSlice([]int{1, 2, 3, 4})
+	var got [][]int
+	for w := range l.Windows(3) {
+		got = append(got, slices.Clone(w))
+	}
+	want := [][]int{{1, 2, 3}, {2, 3, 4}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("l.Windows(3) = %v, want %v", got, want)
+	}
+
+	got = nil
+	for w := range l.Windows(1) {
+		got = append(got, slices.Clone(w))
+	}
+	if want := [][]int{{1}, {2}, {3}, {4}}; !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("l.Windows(1) = %v, want %v", got, want)
+	}
+
+	for w := range l.Windows(5) {
+		t.Errorf("l.Windows(5) yielded %v", w)
+	}
+	// A window larger than any list must not be allocated.
+	for w := range l.Windows(math.MaxInt) {
+		t.Errorf("l.Windows(math.MaxInt) yielded %v", w)
+	}
+}
+
+func TestIterModified(t *testing.T) {
+	l := NewFromSlice([]int{1, 2, 3})
+	mustPanic(t, "All", func() {
+		for range l.All() {
+			l.PushBack(4)
+		}
+	})
+	mustPanic(t, "Backward", func() {
+		for range l.Backward() {
+			l.Remove(l.Front())
+		}
+	})
+	mustPanic(t, "Enumerate", func() {
+		for range l.Enumerate() {
+			l.MoveToBack(l.Front())
+		}
+	})
+	mustPanic(t, "Elements removing next", func() {
+		for e := range l.Elements() {
+			l.Remove(e.Next())
+		}
+	})
+	mustPanic(t, "Elements reusing next", func() {
+		l := NewFromSlice([]int{1, 2, 3})
+		for e := range l.Elements() {
+			if e.Value != 1 {
+				continue
+			}
+			// The pooled element is reused at the back.
+			next := e.Next()
+			l.Remove(next)
+			if l.PushBack(9) != next {
+				t.Fatalf("removed element was not reused")
+			}
+		}
+	})
+
+	// Breaking out right after a modification is fine.
+	for range l.All() {
+		l.PushBack(5)
+		break
+	}
+}
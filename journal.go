@@ -0,0 +1,68 @@
+package list
+
+// ChangeKind identifies the kind of a journaled Change.
+type ChangeKind int
+
+const (
+	ChangeInsert ChangeKind = iota // Values were inserted at Index
+	ChangeRemove                   // Values were removed from Index
+	ChangeMove                     // Values were moved from From to Index
+	ChangeReset                    // the list was rearranged to hold Values
+)
+
+// Change records one structural operation on a list in journal mode.
+// Replaying the changes of a list in order on a copy of its earlier
+// contents reproduces its current contents.
+type Change[E any] struct {
+	Kind ChangeKind
+	// Index is the position of the first affected value: after the change
+	// for inserts and moves, before it for removes, and 0 for resets.
+	Index int
+	// From is the position of the first moved value before a move.
+	From int
+	// Values holds the affected values in list order. For a reset it holds
+	// the entire new contents.
+	Values []E
+}
+
+// SetJournal enables or disables journal mode for list l. In journal mode
+// every insertion, removal and move, including those made by splicing, is
+// recorded as a Change until collected by DrainChanges. Operations that
+// rearrange the whole list, such as Sort, Reverse, Rotate, Shuffle and
+// MergeFunc, are recorded as a single reset. Recording a change finds its
+// position by walking from the nearer end of the list, so changes at the
+// ends, such as PushBack and PopFront, stay O(1), while a change in the
+// middle of the list costs O(n).
+// Disabling journal mode keeps changes already recorded.
+func (l *List[E]) SetJournal(on bool) {
+	l.hookFuncs().journal = on
+	l.dropHooks()
+}
+
+// DrainChanges returns the changes recorded since the last call, oldest
+// first, and clears the journal.
+func (l *List[E]) DrainChanges() []Change[E] {
+	if l.hooks == nil {
+		return nil
+	}
+	changes := l.hooks.changes
+	l.hooks.changes = nil
+	l.dropHooks()
+	return changes
+}
+
+func (h *listHooks[E]) record(kind ChangeKind, index, from int, first, last *Element[E]) {
+	c := Change[E]{Kind: kind, Index: index, From: from}
+	for e := first; ; e = e.next {
+		c.Values = append(c.Values, e.Value)
+		if e == last {
+			break
+		}
+	}
+	if h.journal {
+		h.changes = append(h.changes, c)
+	}
+	if h.txn != nil {
+		h.txn.ops = append(h.txn.ops, txnOp[E]{c, h.before})
+	}
+}
@@ -0,0 +1,124 @@
+package list
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// replay applies changes to s and returns the result.
+func replay[E any](s []E, changes []Change[E]) []E {
+	for _, c := range changes {
+		switch c.Kind {
+		case ChangeInsert:
+			s = slices.Insert(s, c.Index, c.Values...)
+		case ChangeRemove:
+			s = slices.Delete(s, c.Index, c.Index+len(c.Values))
+		case ChangeMove:
+			s = slices.Delete(s, c.From, c.From+len(c.Values))
+			s = slices.Insert(s, c.Index, c.Values...)
+		case ChangeReset:
+			s = slices.Clone(c.Values)
+		}
+	}
+	return s
+}
+
+func TestJournal(t *testing.T) {
+	r := rand.New(rand.NewPCG(9, 10))
+	l := New[int]()
+	l.PushBackMany(1, 2, 3)
+	l.SetJournal(true)
+	base := []int{1, 2, 3}
+
+	ops := []func(){
+		func() { l.PushBack(r.IntN(100)) },
+		func() { l.PushFront(r.IntN(100)) },
+		func() {
+			if e := l.At(r.IntN(l.Len() + 1)); e != nil {
+				l.InsertAfter(r.IntN(100), e)
+			}
+		},
+		func() {
+			if e := l.At(r.IntN(l.Len() + 1)); e != nil {
+				l.Remove(e)
+			}
+		},
+		func() {
+			if l.Len() > 1 {
+				l.MoveBefore(l.At(r.IntN(l.Len())), l.At(r.IntN(l.Len())))
+			}
+		},
+		func() {
+			if l.Len() > 1 {
+				l.Swap(l.At(r.IntN(l.Len())), l.At(r.IntN(l.Len())))
+			}
+		},
+		func() {
+			if l.Len() > 3 {
+				l.MoveRangeBefore(l.At(1), l.At(2), l.Front())
+			}
+		},
+		func() {
+			other := New[int]()
+			other.PushBackMany(r.IntN(100), r.IntN(100))
+			l.AdoptBack(other.Front())
+		},
+		func() {
+			if l.Len() > 2 {
+				l.SplitBefore(l.At(l.Len() - 2))
+			}
+		},
+		func() { l.Rotate(r.IntN(5)) },
+		func() { l.Reverse() },
+		func() { l.SortFunc(cmp.Compare[int]) },
+		func() {
+			other := New[int]()
+			other.PushBackMany(50, 10)
+			l.MergeFunc(other, cmp.Compare[int])
+		},
+		func() {
+			if r.IntN(5) == 0 {
+				l.Init()
+			}
+		},
+	}
+	for range 500 {
+		ops[r.IntN(len(ops))]()
+	}
+	got := replay(base, l.DrainChanges())
+	if want := l.ToSlice(); !slices.Equal(got, want) {
+		t.Errorf("replayed journal = %v, want %v", got, want)
+	}
+	if changes := l.DrainChanges(); len(changes) != 0 {
+		t.Errorf("second DrainChanges returned %d changes", len(changes))
+	}
+
+	l.SetJournal(false)
+	l.PushBack(1)
+	if changes := l.DrainChanges(); len(changes) != 0 || l.hooks != nil {
+		t.Errorf("changes recorded with journal disabled")
+	}
+}
+
+func TestPosition(t *testing.T) {
+	l := New[int]()
+	l.PushBackMany(0, 1, 2, 3, 4, 5, 6)
+	for e := l.Front(); e != nil; e = e.Next() {
+		if got := l.position(e); got != e.Value {
+			t.Errorf("position of %d = %d", e.Value, got)
+		}
+	}
+}
+
+func BenchmarkJournalPushBack(b *testing.B) {
+	l := New[int]()
+	l.SetJournal(true)
+	for i := range b.N {
+		l.PushBack(i)
+		if i%1024 == 0 {
+			l.DrainChanges()
+		}
+	}
+}
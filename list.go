@@ -9,10 +9,31 @@
 //	for e := l.Front(); e != nil; e = e.Next() {
 //		// do something with e.Value
 //	}
+//
+// or, when only the values are needed:
+//
+//	for v := range l.All() {
+//		// do something with v
+//	}
+//
+// The iterators returned by List methods are fail-fast: unless documented
+// otherwise, they panic if the list is structurally modified while they are
+// in progress.
 package list
 
-// Element is an element of a linked list.
+import (
+	"fmt"
+	"slices"
+)
+
+// Element is an element of a linked list. Besides its links and value, each
+// element carries an 8-byte generation counter used to detect stale
+// Handles.
 type Element[E any] struct {
+	// Debugging state, empty unless built with the listdebug tag. It comes
+	// first so that, when empty, it adds no padding.
+	dbg elemDebug
+
 	// Next and previous pointers in the doubly-linked list of elements.
 	// To simplify the implementation, internally a list l is implemented
 	// as a ring, such that &l.root is both the next element of the last
@@ -25,6 +46,9 @@ type Element[E any] struct {
 
 	// The value stored with this element.
 	Value E
+
+	// gen counts the removals of this element, invalidating its handles.
+	gen uint64
 }
 
 // Next returns the next list element or nil.
@@ -43,20 +67,84 @@ func (e *Element[E]) Prev() *Element[E] {
 	return nil
 }
 
+// List returns the list that e belongs to, or nil if e is not an element
+// of any list.
+func (e *Element[E]) List() *List[E] {
+	return e.list
+}
+
+// Detach removes e from the list it belongs to, if any, and returns e.Value.
+// As with Remove, e must not be used after it has been detached.
+func (e *Element[E]) Detach() E {
+	if e.list == nil {
+		return e.Value
+	}
+	return e.list.Remove(e)
+}
+
 // List represents a doubly linked list.
 // The zero value for List is an empty list ready to use.
 type List[E any] struct {
-	root  Element[E]    // sentinel list element, only &root, root.prev, and root.next are used
-	len   int           // current list length excluding (this) sentinel element
-	epool []*Element[E] // Element pool.
+	root    Element[E]    // sentinel list element, only &root, root.prev, and root.next are used
+	len     int           // current list length excluding (this) sentinel element
+	epool   []*Element[E] // Element pool.
+	pcap    int           // pool capacity: 0 for defaultPoolSize, -1 for none
+	strict  bool          // panic on misuse instead of ignoring it
+	handles bool          // handles have been taken, so Init must unlink elements
+	mod     uint64        // structural modification count, checked by iterators
+	alloc   allocator[E]  // shared allocator replacing epool, if not nil
+
+	counters listCounters // reported by Stats
+	metrics  Metrics      // receives operation counts, if not nil
+	hooks    *listHooks[E]
+}
+
+// SetStrict enables or disables strict mode for list l.
+// By default, operations given an element or mark that is not an element of
+// l silently leave the list unmodified. In strict mode they panic instead,
+// naming the list the element actually belongs to, which helps find bugs
+// where elements are used with the wrong list or after removal.
+func (l *List[E]) SetStrict(strict bool) { l.strict = strict }
+
+// misuse reports that op was called with invalid arguments. In strict mode
+// it panics with the formatted description; otherwise it does nothing and
+// the caller leaves the list unmodified.
+func (l *List[E]) misuse(op, format string, args ...any) {
+	if l.strict {
+		panic("list: " + op + ": " + fmt.Sprintf(format, args...))
+	}
+}
+
+// isElem reports whether e is an element of owner, reporting a misuse of op
+// if it is not.
+func (l *List[E]) isElem(op string, e *Element[E], owner *List[E]) bool {
+	switch e.list {
+	case owner:
+		return true
+	case nil:
+		l.misuse(op, "element %p is not an element of any list", e)
+	default:
+		l.misuse(op, "element %p belongs to list %p, not %p", e, e.list, owner)
+	}
+	return false
 }
 
 // Init initializes or clears list l.
 func (l *List[E]) Init() *List[E] {
+	if l.len > 0 {
+		if l.hooks != nil {
+			l.hooks.removing(l, l.root.next, l.root.prev)
+		}
+		l.countRemoves(l.len)
+		if l.handles {
+			l.unlinkAll()
+		}
+	}
 	l.root.next = &l.root
 	l.root.prev = &l.root
 	l.len = 0
 	l.epool = nil
+	l.mod++
 	return l
 }
 
@@ -83,6 +171,70 @@ func (l *List[E]) Back() *Element[E] {
 	return l.root.prev
 }
 
+// FrontValue returns the value of the front element of list l.
+// If l is empty, FrontValue returns the zero value and false.
+func (l *List[E]) FrontValue() (E, bool) {
+	if l.len == 0 {
+		var zero E
+		return zero, false
+	}
+	return l.root.next.Value, true
+}
+
+// BackValue returns the value of the back element of list l.
+// If l is empty, BackValue returns the zero value and false.
+func (l *List[E]) BackValue() (E, bool) {
+	if l.len == 0 {
+		var zero E
+		return zero, false
+	}
+	return l.root.prev.Value, true
+}
+
+// At returns the element at position i of list l, or nil if i is out of range.
+// A negative i counts from the back, so At(-1) is the back element.
+// The list is walked from whichever end is closer to i. At remembers
+// nothing between calls, so each call costs up to half the length of the
+// list; only a Cursor gives fast access to successive indices, making a
+// scan linear rather than quadratic.
+func (l *List[E]) At(i int) *Element[E] {
+	if i < 0 {
+		i += l.len
+	}
+	if i < 0 || i >= l.len {
+		return nil
+	}
+	e, at := l.root.next, 0
+	if back := l.len - 1; back-i < i {
+		e, at = l.root.prev, back
+	}
+	return walk(e, at, i)
+}
+
+// walk returns the element i, given element e at index at.
+func walk[E any](e *Element[E], at, i int) *Element[E] {
+	for ; at < i; at++ {
+		e = e.next
+	}
+	for ; at > i; at-- {
+		e = e.prev
+	}
+	return e
+}
+
+// IndexOf returns the zero-based position of e in list l, or -1 if e is not
+// an element of l. The element must not be nil.
+func (l *List[E]) IndexOf(e *Element[E]) int {
+	if e.list != l {
+		return -1
+	}
+	i := 0
+	for x := l.root.next; x != e; x = x.next {
+		i++
+	}
+	return i
+}
+
 // lazyInit lazily initializes a zero List value.
 func (l *List[E]) lazyInit() {
 	if l.root.next == nil {
@@ -90,20 +242,92 @@ func (l *List[E]) lazyInit() {
 	}
 }
 
+// defaultPoolSize is the pool capacity of a list that has not called
+// SetPoolCapacity.
+const defaultPoolSize = 4
+
+// SetPoolCapacity sets the maximum number of removed elements list l keeps
+// for reuse by later insertions. The default is 4. A capacity of 0
+// disables pooling, so removed elements are never reused and a stale
+// element pointer can never alias a new element. Negative values are
+// treated as 0. Pooled elements beyond the new capacity are released.
+// Lists sharing elements through an Arena or NewSyncPooled do not use
+// their own pool.
+func (l *List[E]) SetPoolCapacity(n int) {
+	if n <= 0 {
+		l.pcap = -1
+	} else {
+		l.pcap = n
+	}
+	if len(l.epool) > max(n, 0) {
+		clear(l.epool[max(n, 0):])
+		l.epool = l.epool[:max(n, 0)]
+	}
+}
+
+// PoolCapacity returns the maximum number of removed elements list l keeps
+// for reuse.
+func (l *List[E]) PoolCapacity() int {
+	switch l.pcap {
+	case 0:
+		return defaultPoolSize
+	case -1:
+		return 0
+	}
+	return l.pcap
+}
+
+// Reserve makes sure at least n elements are available for reuse by later
+// insertions into list l, allocating any shortfall as a single block. A
+// list built after Reserve therefore performs one allocation rather than
+// one per element, and its elements are adjacent in memory. Reserved
+// elements are kept even beyond the pool capacity until they are used.
+// Reserve does nothing for lists sharing elements through an Arena or
+// NewSyncPooled.
+func (l *List[E]) Reserve(n int) {
+	l.lazyInit() // Init would discard the reserved elements
+	n -= len(l.epool)
+	if n <= 0 || l.alloc != nil {
+		return
+	}
+	l.epool = slices.Grow(l.epool, n)
+	block := make([]Element[E], n)
+	l.counters.allocs += uint64(n)
+	// Pool in reverse so that elements are taken in block order.
+	for i := n - 1; i >= 0; i-- {
+		l.epool = append(l.epool, &block[i])
+	}
+}
+
 // insert inserts e after at, increments l.len, and returns e.
 func (l *List[E]) insert(e, at *Element[E]) *Element[E] {
+	if debug {
+		l.debugInsert(e, at)
+	}
 	e.prev = at
 	e.next = at.next
 	e.prev.next = e
 	e.next.prev = e
 	e.list = l
 	l.len++
+	l.mod++
+	l.grew()
+	l.countInserts(1)
+	if l.hooks != nil {
+		l.hooks.inserted(l, e, e)
+	}
 	return e
 }
 
 func (l *List[E]) poolElement(e *Element[E]) {
-	const poolSize = 4
-	if len(l.epool) == poolSize {
+	if debug {
+		l.debugPool(e)
+	}
+	if l.alloc == nil && len(l.epool) >= l.PoolCapacity() {
+		return
+	}
+	if l.alloc != nil {
+		l.alloc.put(e)
 		return
 	}
 	l.epool = append(l.epool, e)
@@ -111,10 +335,24 @@ func (l *List[E]) poolElement(e *Element[E]) {
 
 func (l *List[E]) newElement() *Element[E] {
 	if len(l.epool) == 0 {
+		l.counters.misses++
+		if l.alloc != nil {
+			e := l.alloc.get()
+			if debug {
+				e.dbg.setPooled(false)
+			}
+			return e
+		}
+		l.counters.allocs++
 		return &Element[E]{}
 	}
+	l.counters.hits++
 	e := l.epool[len(l.epool)-1]
 	l.epool = l.epool[:len(l.epool)-1]
+	if debug {
+		assertf(e.list == nil && e.next == nil && e.prev == nil, "pool: reused element %p is still linked", e)
+		e.dbg.setPooled(false)
+	}
 	return e
 }
 
@@ -127,20 +365,41 @@ func (l *List[E]) insertValue(v E, at *Element[E]) *Element[E] {
 
 // remove removes e from its list, decrements l.len
 func (l *List[E]) remove(e *Element[E]) {
+	if debug {
+		l.debugRemove(e)
+	}
+	if l.hooks != nil {
+		l.hooks.removing(l, e, e)
+	}
 	e.prev.next = e.next
 	e.next.prev = e.prev
 	e.next = nil // avoid memory leaks
 	e.prev = nil // avoid memory leaks
 	e.list = nil
+	e.gen++
+	// Don't let the pool, or the siblings of a block allocated element,
+	// keep the removed value alive.
+	var zero E
+	e.Value = zero
 	l.poolElement(e)
 	l.len--
+	l.mod++
+	l.countRemoves(1)
 }
 
 // move moves e to next to at.
 func (l *List[E]) move(e, at *Element[E]) {
+	if debug {
+		l.debugMove(e, at)
+	}
 	if e == at {
 		return
 	}
+	if l.hooks != nil {
+		l.hooks.moving(l, e)
+	}
+	l.mod++
+	l.countMoves(1)
 	e.prev.next = e.next
 	e.next.prev = e.prev
 
@@ -148,19 +407,43 @@ func (l *List[E]) move(e, at *Element[E]) {
 	e.next = at.next
 	e.prev.next = e
 	e.next.prev = e
+	if l.hooks != nil {
+		l.hooks.moved(l, e, e)
+	}
 }
 
 // Remove removes e from l if e is an element of list l.
 // It returns the element value e.Value.
 // The element must not be nil.
 // You must not use e after it has been removed as it may be reused.
-func (l *List[E]) Remove(e *Element[E]) any {
-	if e.list == l {
+func (l *List[E]) Remove(e *Element[E]) E {
+	v := e.Value
+	if l.isElem("Remove", e, l) {
 		// if e.list == l, l must have been initialized when e was inserted
 		// in l or l == nil (e is a zero Element) and l.remove will crash
 		l.remove(e)
 	}
-	return e.Value
+	return v
+}
+
+// PopFront removes the front element of list l and returns its value.
+// If l is empty, PopFront returns the zero value and false.
+func (l *List[E]) PopFront() (E, bool) {
+	if l.len == 0 {
+		var zero E
+		return zero, false
+	}
+	return l.Remove(l.root.next), true
+}
+
+// PopBack removes the back element of list l and returns its value.
+// If l is empty, PopBack returns the zero value and false.
+func (l *List[E]) PopBack() (E, bool) {
+	if l.len == 0 {
+		var zero E
+		return zero, false
+	}
+	return l.Remove(l.root.prev), true
 }
 
 // PushFront inserts a new element e with value v at the front of list l and returns e.
@@ -179,7 +462,7 @@ func (l *List[E]) PushBack(v E) *Element[E] {
 // If mark is not an element of l, the list is not modified.
 // The mark must not be nil.
 func (l *List[E]) InsertBefore(v E, mark *Element[E]) *Element[E] {
-	if mark.list != l {
+	if !l.isElem("InsertBefore", mark, l) {
 		return nil
 	}
 	// see comment in List.Remove about initialization of l
@@ -190,7 +473,7 @@ func (l *List[E]) InsertBefore(v E, mark *Element[E]) *Element[E] {
 // If mark is not an element of l, the list is not modified.
 // The mark must not be nil.
 func (l *List[E]) InsertAfter(v E, mark *Element[E]) *Element[E] {
-	if mark.list != l {
+	if !l.isElem("InsertAfter", mark, l) {
 		return nil
 	}
 	// see comment in List.Remove about initialization of l
@@ -201,7 +484,7 @@ func (l *List[E]) InsertAfter(v E, mark *Element[E]) *Element[E] {
 // If e is not an element of l, the list is not modified.
 // The element must not be nil.
 func (l *List[E]) MoveToFront(e *Element[E]) {
-	if e.list != l || l.root.next == e {
+	if !l.isElem("MoveToFront", e, l) || l.root.next == e {
 		return
 	}
 	// see comment in List.Remove about initialization of l
@@ -212,7 +495,7 @@ func (l *List[E]) MoveToFront(e *Element[E]) {
 // If e is not an element of l, the list is not modified.
 // The element must not be nil.
 func (l *List[E]) MoveToBack(e *Element[E]) {
-	if e.list != l || l.root.prev == e {
+	if !l.isElem("MoveToBack", e, l) || l.root.prev == e {
 		return
 	}
 	// see comment in List.Remove about initialization of l
@@ -223,7 +506,7 @@ func (l *List[E]) MoveToBack(e *Element[E]) {
 // If e or mark is not an element of l, or e == mark, the list is not modified.
 // The element and mark must not be nil.
 func (l *List[E]) MoveBefore(e, mark *Element[E]) {
-	if e.list != l || e == mark || mark.list != l {
+	if !l.isElem("MoveBefore", e, l) || !l.isElem("MoveBefore", mark, l) || e == mark {
 		return
 	}
 	l.move(e, mark.prev)
@@ -233,7 +516,7 @@ func (l *List[E]) MoveBefore(e, mark *Element[E]) {
 // If e or mark is not an element of l, or e == mark, the list is not modified.
 // The element and mark must not be nil.
 func (l *List[E]) MoveAfter(e, mark *Element[E]) {
-	if e.list != l || e == mark || mark.list != l {
+	if !l.isElem("MoveAfter", e, l) || !l.isElem("MoveAfter", mark, l) || e == mark {
 		return
 	}
 	l.move(e, mark)
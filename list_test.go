@@ -4,7 +4,10 @@
 
 package list
 
-import "testing"
+import (
+	"testing"
+	"unsafe"
+)
 
 func checkListLen[E any](t *testing.T, l *List[E], len int) bool {
 	if n := l.Len(); n != len {
@@ -337,3 +340,266 @@ func TestMoveUnknownMark(t *testing.T) {
 	checkList(t, &l1, []any{1})
 	checkList(t, &l2, []any{2})
 }
+
+func TestRemoveValue(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+	if v := l.Remove(e1); v != 1 {
+		t.Errorf("l.Remove(e1) = %d, want 1", v)
+	}
+	other := New[int]()
+	if v := other.Remove(e2); v != 2 {
+		t.Errorf("other.Remove(e2) = %d, want 2", v)
+	}
+	checkListPointers(t, l, []*Element[int]{e2})
+}
+
+func TestAt(t *testing.T) {
+	l := New[int]()
+	var es []*Element[int]
+	for i := 0; i < 5; i++ {
+		es = append(es, l.PushBack(i))
+	}
+	for i := -7; i < 7; i++ {
+		var want *Element[int]
+		switch {
+		case i >= 0 && i < len(es):
+			want = es[i]
+		case i < 0 && i >= -len(es):
+			want = es[len(es)+i]
+		}
+		if e := l.At(i); e != want {
+			t.Errorf("l.At(%d) = %p, want %p", i, e, want)
+		}
+	}
+	var z List[int]
+	if e := z.At(0); e != nil {
+		t.Errorf("At(0) on empty list = %p, want nil", e)
+	}
+}
+
+func TestIndexOf(t *testing.T) {
+	l := New[int]()
+	e0 := l.PushBack(0)
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+	for i, e := range []*Element[int]{e0, e1, e2} {
+		if got := l.IndexOf(e); got != i {
+			t.Errorf("l.IndexOf(e%d) = %d, want %d", i, got, i)
+		}
+	}
+	other := New[int]()
+	if got := other.IndexOf(e1); got != -1 {
+		t.Errorf("other.IndexOf(e1) = %d, want -1", got)
+	}
+	if got := l.IndexOf(new(Element[int])); got != -1 {
+		t.Errorf("l.IndexOf(new element) = %d, want -1", got)
+	}
+}
+
+func TestPop(t *testing.T) {
+	var l List[int]
+	if v, ok := l.PopFront(); ok || v != 0 {
+		t.Errorf("PopFront on empty list = %d, %v; want 0, false", v, ok)
+	}
+	if v, ok := l.PopBack(); ok || v != 0 {
+		t.Errorf("PopBack on empty list = %d, %v; want 0, false", v, ok)
+	}
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushBack(3)
+	if v, ok := l.PopFront(); !ok || v != 1 {
+		t.Errorf("l.PopFront() = %d, %v; want 1, true", v, ok)
+	}
+	if v, ok := l.PopBack(); !ok || v != 3 {
+		t.Errorf("l.PopBack() = %d, %v; want 3, true", v, ok)
+	}
+	checkListLen(t, &l, 1)
+	if v, ok := l.PopBack(); !ok || v != 2 {
+		t.Errorf("l.PopBack() = %d, %v; want 2, true", v, ok)
+	}
+	checkListPointers(t, &l, []*Element[int]{})
+}
+
+func TestFrontBackValue(t *testing.T) {
+	var l List[string]
+	if v, ok := l.FrontValue(); ok || v != "" {
+		t.Errorf("FrontValue on empty list = %q, %v; want \"\", false", v, ok)
+	}
+	if v, ok := l.BackValue(); ok || v != "" {
+		t.Errorf("BackValue on empty list = %q, %v; want \"\", false", v, ok)
+	}
+	l.PushBack("a")
+	l.PushBack("b")
+	if v, ok := l.FrontValue(); !ok || v != "a" {
+		t.Errorf("l.FrontValue() = %q, %v; want \"a\", true", v, ok)
+	}
+	if v, ok := l.BackValue(); !ok || v != "b" {
+		t.Errorf("l.BackValue() = %q, %v; want \"b\", true", v, ok)
+	}
+	checkListLen(t, &l, 2)
+}
+
+func TestElementList(t *testing.T) {
+	l := New[int]()
+	e := l.PushBack(1)
+	if got := e.List(); got != l {
+		t.Errorf("e.List() = %p, want %p", got, l)
+	}
+	if got := new(Element[int]).List(); got != nil {
+		t.Errorf("new(Element).List() = %p, want nil", got)
+	}
+	l.Remove(e)
+	if got := e.List(); got != nil {
+		t.Errorf("removed e.List() = %p, want nil", got)
+	}
+}
+
+func TestDetach(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+	if v := e1.Detach(); v != 1 {
+		t.Errorf("e1.Detach() = %d, want 1", v)
+	}
+	checkListPointers(t, l, []*Element[int]{e2})
+	e := &Element[int]{Value: 3}
+	if v := e.Detach(); v != 3 {
+		t.Errorf("Detach of free element = %d, want 3", v)
+	}
+}
+
+func TestStrict(t *testing.T) {
+	l1 := New[int]()
+	l2 := New[int]()
+	e1 := l1.PushBack(1)
+	e2 := l2.PushBack(2)
+
+	// Without strict mode misuse is ignored.
+	l1.MoveToFront(e2)
+	l1.InsertBefore(3, e2)
+	checkList(t, Map(l1, func(v int) any { return v }), []any{1})
+
+	l1.SetStrict(true)
+	mustPanic(t, "Remove", func() { l1.Remove(e2) })
+	mustPanic(t, "InsertBefore", func() { l1.InsertBefore(3, e2) })
+	mustPanic(t, "InsertAfter", func() { l1.InsertAfter(3, e2) })
+	mustPanic(t, "MoveToFront", func() { l1.MoveToFront(e2) })
+	mustPanic(t, "MoveToBack", func() { l1.MoveToBack(e2) })
+	mustPanic(t, "MoveBefore", func() { l1.MoveBefore(e1, e2) })
+	mustPanic(t, "MoveAfter", func() { l1.MoveAfter(e2, e1) })
+	mustPanic(t, "Swap", func() { l1.Swap(e1, e2) })
+	mustPanic(t, "SplitBefore", func() { l1.SplitBefore(e2) })
+
+	l1.Remove(e1)
+	mustPanic(t, "Remove of removed element", func() { l1.Remove(e1) })
+
+	// Valid operations still work in strict mode.
+	e3 := l1.PushBack(3)
+	e4 := l1.InsertBefore(4, e3)
+	l1.MoveAfter(e4, e3)
+	checkListPointers(t, l1, []*Element[int]{e3, e4})
+}
+
+func TestPoolCapacity(t *testing.T) {
+	var l List[int]
+	if n := l.PoolCapacity(); n != 4 {
+		t.Errorf("default PoolCapacity = %d, want 4", n)
+	}
+	fill := func(n int) {
+		es := make([]*Element[int], n)
+		for i := range es {
+			es[i] = l.PushBack(i)
+		}
+		for _, e := range es {
+			l.Remove(e)
+		}
+	}
+	fill(10)
+	if len(l.epool) != 4 {
+		t.Errorf("pooled %d elements, want 4", len(l.epool))
+	}
+
+	l.SetPoolCapacity(8)
+	fill(10)
+	if len(l.epool) != 8 || l.PoolCapacity() != 8 {
+		t.Errorf("pooled %d elements with capacity %d, want 8", len(l.epool), l.PoolCapacity())
+	}
+	l.SetPoolCapacity(2)
+	if len(l.epool) != 2 {
+		t.Errorf("shrinking capacity left %d pooled elements", len(l.epool))
+	}
+
+	l.SetPoolCapacity(0)
+	if l.PoolCapacity() != 0 || len(l.epool) != 0 {
+		t.Errorf("disabled pool has capacity %d, %d pooled", l.PoolCapacity(), len(l.epool))
+	}
+	e := l.PushBack(1)
+	l.Remove(e)
+	if f := l.PushBack(2); f == e {
+		t.Errorf("removed element reused with pooling disabled")
+	}
+}
+
+func TestReserve(t *testing.T) {
+	var l List[int]
+	l.Reserve(200)
+	if len(l.epool) != 200 {
+		t.Fatalf("pooled %d elements after Reserve(200)", len(l.epool))
+	}
+	l.Reserve(50)
+	if len(l.epool) != 200 {
+		t.Errorf("Reserve(50) changed the pool to %d elements", len(l.epool))
+	}
+	// AllocsPerRun makes a warm-up call, so this pushes 200 values.
+	if n := testing.AllocsPerRun(1, func() {
+		for i := range 100 {
+			l.PushBack(i)
+		}
+	}); n != 0 {
+		t.Errorf("pushing reserved elements allocated %v times", n)
+	}
+	// Elements are taken in block order.
+	for e := l.Front(); e.Next() != nil; e = e.Next() {
+		if uintptr(unsafe.Pointer(e.Next())) != uintptr(unsafe.Pointer(e))+unsafe.Sizeof(*e) {
+			t.Fatalf("reserved elements are not adjacent")
+		}
+	}
+	if err := l.CheckInvariants(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPoolZeroesValues(t *testing.T) {
+	var l List[[]byte]
+	e := l.PushBack(make([]byte, 1<<20))
+	if v := l.Remove(e); len(v) != 1<<20 {
+		t.Errorf("Remove returned %d bytes, want %d", len(v), 1<<20)
+	}
+	if len(l.epool) != 1 || l.epool[0].Value != nil {
+		t.Errorf("pooled element still holds its value")
+	}
+}
+
+func TestRemoveZeroesBlockValues(t *testing.T) {
+	vals := make([][]byte, 8)
+	for i := range vals {
+		vals[i] = make([]byte, 1<<10)
+	}
+	l := NewFromSlice(vals)
+	l.SetPoolCapacity(0)
+	var removed []*Element[[]byte]
+	for l.Len() > 1 {
+		e := l.Back()
+		l.Remove(e)
+		removed = append(removed, e)
+	}
+	// The removed elements share a block with the front element, which is
+	// still in use, so they must not keep their values alive.
+	for _, e := range removed {
+		if e.Value != nil {
+			t.Fatalf("removed block element still holds its value")
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package lru
+
+import (
+	list "github.com/andrewchambers/list-go"
+)
+
+// ARCCache is an adaptive replacement cache holding at most a fixed number
+// of entries. It tracks both recently and frequently used entries, along
+// with ghost lists of recently evicted keys, and adapts the balance between
+// the two to the workload. Unlike a plain LRU cache, a single scan over many
+// keys does not flush frequently used entries.
+// An ARCCache is not safe for concurrent use.
+type ARCCache[K comparable, V any] struct {
+	size    int
+	p       int // target size of t1
+	items   map[K]*list.Element[entry[K, V]]
+	t1, t2  list.List[entry[K, V]] // resident entries seen once and more than once
+	b1, b2  list.List[entry[K, V]] // ghost keys evicted from t1 and t2
+	onEvict func(K, V)
+	metrics list.Metrics
+}
+
+// NewARC returns an adaptive replacement cache holding at most size
+// entries. If onEvict is not nil, it is called with each entry evicted to
+// make room for a new one. NewARC panics if size is not positive.
+func NewARC[K comparable, V any](size int, onEvict func(K, V)) *ARCCache[K, V] {
+	if size <= 0 {
+		panic("lru: size must be positive")
+	}
+	return &ARCCache[K, V]{
+		size:    size,
+		items:   make(map[K]*list.Element[entry[K, V]], 2*size),
+		onEvict: onEvict,
+	}
+}
+
+// resident returns the element for key if its value is cached.
+func (c *ARCCache[K, V]) resident(key K) (*list.Element[entry[K, V]], bool) {
+	e, ok := c.items[key]
+	if !ok || (e.List() != &c.t1 && e.List() != &c.t2) {
+		return nil, false
+	}
+	return e, true
+}
+
+// Get returns the value for key and marks it frequently used.
+func (c *ARCCache[K, V]) Get(key K) (value V, ok bool) {
+	e, ok := c.resident(key)
+	if !ok {
+		return value, false
+	}
+	c.t2.AdoptFront(e)
+	return e.Value.value, true
+}
+
+// Peek returns the value for key without updating its usage.
+func (c *ARCCache[K, V]) Peek(key K) (value V, ok bool) {
+	e, ok := c.resident(key)
+	if !ok {
+		return value, false
+	}
+	return e.Value.value, true
+}
+
+// Contains reports whether key is in the cache without updating its usage.
+func (c *ARCCache[K, V]) Contains(key K) bool {
+	_, ok := c.resident(key)
+	return ok
+}
+
+// Add sets the value for key and reports whether an entry was evicted to
+// make room.
+func (c *ARCCache[K, V]) Add(key K, value V) (evicted bool) {
+	if e, ok := c.items[key]; ok {
+		switch e.List() {
+		case &c.t1, &c.t2:
+			e.Value.value = value
+			c.t2.AdoptFront(e)
+			return false
+		case &c.b1:
+			c.p = min(c.size, c.p+max(c.b2.Len()/c.b1.Len(), 1))
+			evicted = c.replace(false)
+		case &c.b2:
+			c.p = max(0, c.p-max(c.b1.Len()/c.b2.Len(), 1))
+			evicted = c.replace(true)
+		}
+		// A ghost hit brings the key back as frequently used.
+		e.Value.value = value
+		c.t2.AdoptFront(e)
+		return evicted
+	}
+
+	switch {
+	case c.t1.Len()+c.b1.Len() == c.size:
+		if c.t1.Len() < c.size {
+			c.dropGhost(&c.b1)
+			evicted = c.replace(false)
+		} else {
+			c.evict(&c.t1)
+			evicted = true
+		}
+	case c.t1.Len()+c.t2.Len()+c.b1.Len()+c.b2.Len() >= c.size:
+		if c.t1.Len()+c.t2.Len()+c.b1.Len()+c.b2.Len() == 2*c.size {
+			c.dropGhost(&c.b2)
+		}
+		evicted = c.replace(false)
+	}
+	c.items[key] = c.t1.PushFront(entry[K, V]{key, value})
+	return evicted
+}
+
+// replace makes room for a new resident entry when the cache is full by
+// demoting the least recently used entry of t1 or t2 to its ghost list,
+// and reports whether an entry was evicted.
+func (c *ARCCache[K, V]) replace(inB2 bool) bool {
+	if c.t1.Len()+c.t2.Len() < c.size {
+		return false
+	}
+	from, ghost := &c.t2, &c.b2
+	if n := c.t1.Len(); n > 0 && (n > c.p || (inB2 && n == c.p)) {
+		from, ghost = &c.t1, &c.b1
+	}
+	e := from.Back()
+	if e == nil {
+		return false
+	}
+	ent := e.Value
+	var zero V
+	e.Value.value = zero // ghosts keep only the key
+	ghost.AdoptFront(e)
+	c.evicted(ent)
+	return true
+}
+
+// evict removes the least recently used entry of l without keeping a ghost.
+func (c *ARCCache[K, V]) evict(l *list.List[entry[K, V]]) {
+	ent, ok := l.PopBack()
+	if !ok {
+		return
+	}
+	delete(c.items, ent.key)
+	c.evicted(ent)
+}
+
+func (c *ARCCache[K, V]) evicted(ent entry[K, V]) {
+	if c.metrics != nil {
+		c.metrics.AddEvictions(1)
+	}
+	if c.onEvict != nil {
+		c.onEvict(ent.key, ent.value)
+	}
+}
+
+// dropGhost forgets the oldest key of the ghost list l.
+func (c *ARCCache[K, V]) dropGhost(l *list.List[entry[K, V]]) {
+	if ent, ok := l.PopBack(); ok {
+		delete(c.items, ent.key)
+	}
+}
+
+// Remove removes key from the cache, including its ghost entry, and reports
+// whether its value was cached. The eviction callback is not called.
+func (c *ARCCache[K, V]) Remove(key K) bool {
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	l := e.List()
+	delete(c.items, key)
+	l.Remove(e)
+	return l == &c.t1 || l == &c.t2
+}
+
+// Len returns the number of cached entries.
+func (c *ARCCache[K, V]) Len() int { return c.t1.Len() + c.t2.Len() }
+
+// Purge removes all entries and ghost keys from the cache without calling
+// the eviction callback.
+func (c *ARCCache[K, V]) Purge() {
+	clear(c.items)
+	c.t1.Truncate(0)
+	c.t2.Truncate(0)
+	c.b1.Truncate(0)
+	c.b2.Truncate(0)
+	c.p = 0
+}
+
+// SetMetrics makes the cache report insertions, removals and moves of
+// resident entries, and evictions, to m, or stops reporting if m is nil.
+func (c *ARCCache[K, V]) SetMetrics(m list.Metrics) {
+	c.metrics = m
+	c.t1.SetMetrics(m)
+	c.t2.SetMetrics(m)
+}
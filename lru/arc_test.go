@@ -0,0 +1,76 @@
+package lru
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestARCCache(t *testing.T) {
+	var evicted []int
+	c := NewARC(3, func(k, v int) { evicted = append(evicted, k) })
+	for i := 0; i < 3; i++ {
+		c.Add(i, i*10)
+	}
+	// Promote 0 and 1 to the frequently used list.
+	c.Get(0)
+	c.Get(1)
+
+	// A scan over new keys only displaces recently used entries.
+	for i := 100; i < 110; i++ {
+		c.Add(i, i)
+	}
+	for _, k := range []int{0, 1} {
+		if v, ok := c.Get(k); !ok || v != k*10 {
+			t.Errorf("c.Get(%d) = %d, %v; want %d, true", k, v, ok, k*10)
+		}
+	}
+	if c.Len() != 3 {
+		t.Errorf("c.Len() = %d, want 3", c.Len())
+	}
+	if len(evicted) != 10 {
+		t.Errorf("evicted %d entries, want 10", len(evicted))
+	}
+
+	// Re-adding a ghost key brings it back as frequently used.
+	c.Add(2, 20)
+	if v, ok := c.Peek(2); !ok || v != 20 {
+		t.Errorf("c.Peek(2) = %d, %v; want 20, true", v, ok)
+	}
+	if !c.Remove(2) || c.Contains(2) {
+		t.Errorf("Remove(2) failed")
+	}
+	c.Purge()
+	if c.Len() != 0 {
+		t.Errorf("c.Len() after Purge = %d", c.Len())
+	}
+}
+
+func TestARCCacheInvariants(t *testing.T) {
+	const size = 16
+	c := NewARC[int, int](size, nil)
+	r := rand.New(rand.NewSource(4))
+	for i := 0; i < 20000; i++ {
+		k := r.Intn(64)
+		if r.Intn(4) == 0 {
+			k = r.Intn(8) // a hot set
+		}
+		if _, ok := c.Get(k); !ok {
+			c.Add(k, k)
+		}
+		if r.Intn(50) == 0 {
+			c.Remove(r.Intn(64))
+		}
+		resident := c.t1.Len() + c.t2.Len()
+		total := resident + c.b1.Len() + c.b2.Len()
+		if resident > size || c.t1.Len()+c.b1.Len() > size || total > 2*size {
+			t.Fatalf("step %d: |t1|=%d |t2|=%d |b1|=%d |b2|=%d exceed size %d",
+				i, c.t1.Len(), c.t2.Len(), c.b1.Len(), c.b2.Len(), size)
+		}
+		if len(c.items) != total {
+			t.Fatalf("step %d: %d map entries for %d list entries", i, len(c.items), total)
+		}
+		if c.p < 0 || c.p > size {
+			t.Fatalf("step %d: p = %d out of range", i, c.p)
+		}
+	}
+}
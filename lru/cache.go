@@ -0,0 +1,62 @@
+package lru
+
+import (
+	list "github.com/andrewchambers/list-go"
+)
+
+// Interface is the set of operations shared by every cache in this package,
+// so code can be written independently of the eviction policy.
+type Interface[K comparable, V any] interface {
+	// Add sets the value for key and reports whether an entry was evicted
+	// to make room.
+	Add(key K, value V) (evicted bool)
+	// Get returns the value for key, recording the access.
+	Get(key K) (value V, ok bool)
+	// Peek returns the value for key without recording an access.
+	Peek(key K) (value V, ok bool)
+	// Contains reports whether key is cached without recording an access.
+	Contains(key K) bool
+	// Remove removes key and reports whether it was cached.
+	Remove(key K) bool
+	// Len returns the number of cached entries.
+	Len() int
+	// Purge removes all entries.
+	Purge()
+	// SetMetrics makes the cache report its operations to m.
+	SetMetrics(m list.Metrics)
+}
+
+// Policy selects the eviction policy of a cache created by NewWithPolicy.
+type Policy int
+
+const (
+	LRU      Policy = iota // least recently used, see New
+	ARC                    // adaptive replacement, see NewARC
+	TwoQueue               // 2Q, see New2Q
+	Sieve                  // SIEVE, see NewSieve
+)
+
+// NewWithPolicy returns a cache holding at most size entries that evicts
+// according to policy. If onEvict is not nil, it is called with each entry
+// evicted to make room for a new one. NewWithPolicy panics if size is not
+// positive or policy is unknown.
+func NewWithPolicy[K comparable, V any](policy Policy, size int, onEvict func(K, V)) Interface[K, V] {
+	switch policy {
+	case LRU:
+		return New(size, onEvict)
+	case ARC:
+		return NewARC(size, onEvict)
+	case TwoQueue:
+		return New2Q(size, onEvict)
+	case Sieve:
+		return NewSieve(size, onEvict)
+	}
+	panic("lru: unknown policy")
+}
+
+var (
+	_ Interface[int, int] = (*Cache[int, int])(nil)
+	_ Interface[int, int] = (*ARCCache[int, int])(nil)
+	_ Interface[int, int] = (*TwoQueueCache[int, int])(nil)
+	_ Interface[int, int] = (*SieveCache[int, int])(nil)
+)
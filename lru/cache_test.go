@@ -0,0 +1,24 @@
+package lru
+
+import (
+	"testing"
+
+	list "github.com/andrewchambers/list-go"
+)
+
+func TestSetMetrics(t *testing.T) {
+	for _, p := range []Policy{LRU, ARC, TwoQueue, Sieve} {
+		var m list.Counters
+		c := NewWithPolicy[int, int](p, 4, nil)
+		c.SetMetrics(&m)
+		for i := range 10 {
+			c.Add(i, i)
+		}
+		if got := m.Evictions.Load(); got != 6 {
+			t.Errorf("policy %d: %d evictions, want 6", p, got)
+		}
+		if got := m.Inserts.Load(); got < 10 {
+			t.Errorf("policy %d: %d inserts, want at least 10", p, got)
+		}
+	}
+}
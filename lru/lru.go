@@ -0,0 +1,131 @@
+// Package lru implements fixed-size caches built on list-go lists.
+package lru
+
+import (
+	list "github.com/andrewchambers/list-go"
+)
+
+// Cache is a least-recently-used cache holding at most a fixed number of
+// entries. Get and Add mark an entry as most recently used; when the cache
+// is full, adding a new key evicts the least recently used entry.
+// A Cache is not safe for concurrent use.
+type Cache[K comparable, V any] struct {
+	size    int
+	items   map[K]*list.Element[entry[K, V]]
+	order   list.List[entry[K, V]] // front is most recently used
+	onEvict func(K, V)
+	metrics list.Metrics
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// New returns an LRU cache holding at most size entries. If onEvict is not
+// nil, it is called with each entry evicted to make room for a new one.
+// New panics if size is not positive.
+func New[K comparable, V any](size int, onEvict func(K, V)) *Cache[K, V] {
+	if size <= 0 {
+		panic("lru: size must be positive")
+	}
+	return &Cache[K, V]{
+		size:    size,
+		items:   make(map[K]*list.Element[entry[K, V]], size),
+		onEvict: onEvict,
+	}
+}
+
+// Add sets the value for key, marking it most recently used, and reports
+// whether an entry was evicted to make room.
+func (c *Cache[K, V]) Add(key K, value V) (evicted bool) {
+	if e, ok := c.items[key]; ok {
+		e.Value.value = value
+		c.order.MoveToFront(e)
+		return false
+	}
+	if c.order.Len() >= c.size {
+		c.evictOldest()
+		evicted = true
+	}
+	c.items[key] = c.order.PushFront(entry[K, V]{key, value})
+	return evicted
+}
+
+// Get returns the value for key and marks it most recently used.
+func (c *Cache[K, V]) Get(key K) (value V, ok bool) {
+	e, ok := c.items[key]
+	if !ok {
+		return value, false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.value, true
+}
+
+// Peek returns the value for key without marking it used.
+func (c *Cache[K, V]) Peek(key K) (value V, ok bool) {
+	e, ok := c.items[key]
+	if !ok {
+		return value, false
+	}
+	return e.Value.value, true
+}
+
+// Contains reports whether key is in the cache without marking it used.
+func (c *Cache[K, V]) Contains(key K) bool {
+	_, ok := c.items[key]
+	return ok
+}
+
+// Remove removes key from the cache and reports whether it was present.
+// The eviction callback is not called.
+func (c *Cache[K, V]) Remove(key K) bool {
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	delete(c.items, key)
+	c.order.Remove(e)
+	return true
+}
+
+// Len returns the number of entries in the cache.
+func (c *Cache[K, V]) Len() int { return c.order.Len() }
+
+// Purge removes all entries from the cache without calling the eviction
+// callback.
+func (c *Cache[K, V]) Purge() {
+	clear(c.items)
+	c.order.Truncate(0)
+}
+
+// Keys returns the keys in the cache from most to least recently used.
+func (c *Cache[K, V]) Keys() []K {
+	keys := make([]K, 0, c.order.Len())
+	for e := range c.order.All() {
+		keys = append(keys, e.key)
+	}
+	return keys
+}
+
+func (c *Cache[K, V]) evictOldest() {
+	e := c.order.Back()
+	if e == nil {
+		return
+	}
+	ent := c.order.Remove(e)
+	delete(c.items, ent.key)
+	if c.metrics != nil {
+		c.metrics.AddEvictions(1)
+	}
+	if c.onEvict != nil {
+		c.onEvict(ent.key, ent.value)
+	}
+}
+
+// SetMetrics makes the cache report insertions, removals and moves of
+// resident entries, and evictions, to m, or stops reporting if m is nil.
+func (c *Cache[K, V]) SetMetrics(m list.Metrics) {
+	c.metrics = m
+	c.order.SetMetrics(m)
+}
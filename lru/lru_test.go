@@ -0,0 +1,69 @@
+package lru
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCache(t *testing.T) {
+	var evicted []int
+	c := New(2, func(k int, v string) { evicted = append(evicted, k) })
+
+	c.Add(1, "one")
+	c.Add(2, "two")
+	if v, ok := c.Get(1); !ok || v != "one" {
+		t.Errorf("c.Get(1) = %q, %v; want \"one\", true", v, ok)
+	}
+	if !c.Add(3, "three") {
+		t.Errorf("c.Add(3) did not report an eviction")
+	}
+	if c.Contains(2) {
+		t.Errorf("least recently used key 2 was not evicted")
+	}
+	if got, want := c.Keys(), []int{3, 1}; !slices.Equal(got, want) {
+		t.Errorf("c.Keys() = %v, want %v", got, want)
+	}
+
+	// Peek does not refresh recency.
+	if v, ok := c.Peek(1); !ok || v != "one" {
+		t.Errorf("c.Peek(1) = %q, %v; want \"one\", true", v, ok)
+	}
+	c.Add(4, "four")
+	if c.Contains(1) {
+		t.Errorf("key 1 survived eviction after Peek")
+	}
+	if want := []int{2, 1}; !slices.Equal(evicted, want) {
+		t.Errorf("evicted %v, want %v", evicted, want)
+	}
+
+	// Updating an existing key does not evict.
+	if c.Add(4, "FOUR") {
+		t.Errorf("updating key 4 reported an eviction")
+	}
+	if v, _ := c.Get(4); v != "FOUR" {
+		t.Errorf("c.Get(4) = %q, want \"FOUR\"", v)
+	}
+
+	if !c.Remove(3) || c.Remove(3) {
+		t.Errorf("Remove(3) did not report presence correctly")
+	}
+	if c.Len() != 1 {
+		t.Errorf("c.Len() = %d, want 1", c.Len())
+	}
+	c.Purge()
+	if c.Len() != 0 || c.Contains(4) {
+		t.Errorf("Purge left entries behind")
+	}
+	if want := []int{2, 1}; !slices.Equal(evicted, want) {
+		t.Errorf("Remove or Purge called the eviction callback: %v", evicted)
+	}
+}
+
+func TestNewPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("New(0) did not panic")
+		}
+	}()
+	New[int, int](0, nil)
+}
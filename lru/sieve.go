@@ -0,0 +1,137 @@
+package lru
+
+import (
+	list "github.com/andrewchambers/list-go"
+)
+
+// SieveCache is a cache using the SIEVE eviction algorithm, holding at most
+// a fixed number of entries. Entries are kept in insertion order and a hit
+// only sets a visited bit, so reads never reorder the list; on eviction a
+// hand sweeps from the oldest entry towards the newest, clearing visited
+// bits until it finds an unvisited entry to evict.
+// A SieveCache is not safe for concurrent use.
+type SieveCache[K comparable, V any] struct {
+	size    int
+	items   map[K]*list.Element[sieveEntry[K, V]]
+	order   list.List[sieveEntry[K, V]] // newest at front
+	hand    *list.Element[sieveEntry[K, V]]
+	onEvict func(K, V)
+	metrics list.Metrics
+}
+
+type sieveEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	visited bool
+}
+
+// NewSieve returns a SIEVE cache holding at most size entries. If onEvict is
+// not nil, it is called with each entry evicted to make room for a new one.
+// NewSieve panics if size is not positive.
+func NewSieve[K comparable, V any](size int, onEvict func(K, V)) *SieveCache[K, V] {
+	if size <= 0 {
+		panic("lru: size must be positive")
+	}
+	return &SieveCache[K, V]{
+		size:    size,
+		items:   make(map[K]*list.Element[sieveEntry[K, V]], size),
+		onEvict: onEvict,
+	}
+}
+
+// Get returns the value for key and marks it visited.
+func (c *SieveCache[K, V]) Get(key K) (value V, ok bool) {
+	e, ok := c.items[key]
+	if !ok {
+		return value, false
+	}
+	e.Value.visited = true
+	return e.Value.value, true
+}
+
+// Peek returns the value for key without marking it visited.
+func (c *SieveCache[K, V]) Peek(key K) (value V, ok bool) {
+	e, ok := c.items[key]
+	if !ok {
+		return value, false
+	}
+	return e.Value.value, true
+}
+
+// Contains reports whether key is in the cache without marking it visited.
+func (c *SieveCache[K, V]) Contains(key K) bool {
+	_, ok := c.items[key]
+	return ok
+}
+
+// Add sets the value for key and reports whether an entry was evicted to
+// make room. Updating an existing key marks it visited.
+func (c *SieveCache[K, V]) Add(key K, value V) (evicted bool) {
+	if e, ok := c.items[key]; ok {
+		e.Value.value = value
+		e.Value.visited = true
+		return false
+	}
+	if c.order.Len() >= c.size {
+		c.evict()
+		evicted = true
+	}
+	c.items[key] = c.order.PushFront(sieveEntry[K, V]{key: key, value: value})
+	return evicted
+}
+
+// evict moves the hand to the next unvisited entry and evicts it.
+func (c *SieveCache[K, V]) evict() {
+	e := c.hand
+	if e == nil {
+		e = c.order.Back()
+	}
+	for e.Value.visited {
+		e.Value.visited = false
+		if e = e.Prev(); e == nil {
+			e = c.order.Back()
+		}
+	}
+	c.hand = e.Prev()
+	ent := c.order.Remove(e)
+	delete(c.items, ent.key)
+	if c.metrics != nil {
+		c.metrics.AddEvictions(1)
+	}
+	if c.onEvict != nil {
+		c.onEvict(ent.key, ent.value)
+	}
+}
+
+// Remove removes key from the cache and reports whether it was present.
+// The eviction callback is not called.
+func (c *SieveCache[K, V]) Remove(key K) bool {
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	if e == c.hand {
+		c.hand = e.Prev()
+	}
+	delete(c.items, key)
+	c.order.Remove(e)
+	return true
+}
+
+// Len returns the number of cached entries.
+func (c *SieveCache[K, V]) Len() int { return c.order.Len() }
+
+// Purge removes all entries from the cache without calling the eviction
+// callback.
+func (c *SieveCache[K, V]) Purge() {
+	clear(c.items)
+	c.order.Truncate(0)
+	c.hand = nil
+}
+
+// SetMetrics makes the cache report insertions, removals and moves of
+// resident entries, and evictions, to m, or stops reporting if m is nil.
+func (c *SieveCache[K, V]) SetMetrics(m list.Metrics) {
+	c.metrics = m
+	c.order.SetMetrics(m)
+}
@@ -0,0 +1,49 @@
+package lru
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSieveCache(t *testing.T) {
+	var evicted []int
+	c := NewSieve(3, func(k, v int) { evicted = append(evicted, k) })
+	c.Add(1, 1)
+	c.Add(2, 2)
+	c.Add(3, 3)
+	c.Get(1)
+	c.Get(3)
+
+	// The hand skips the visited 1, clearing its bit, and evicts 2.
+	c.Add(4, 4)
+	if want := []int{2}; !slices.Equal(evicted, want) {
+		t.Errorf("evicted %v, want %v", evicted, want)
+	}
+	// The hand resumes at 3, clears its bit and evicts 4, which has not
+	// been visited since it was added.
+	c.Add(5, 5)
+	if want := []int{2, 4}; !slices.Equal(evicted, want) {
+		t.Errorf("evicted %v, want %v", evicted, want)
+	}
+	for _, k := range []int{1, 3, 5} {
+		if !c.Contains(k) {
+			t.Errorf("key %d missing", k)
+		}
+	}
+
+	if v, ok := c.Peek(5); !ok || v != 5 {
+		t.Errorf("c.Peek(5) = %d, %v; want 5, true", v, ok)
+	}
+	if !c.Remove(1) || c.Contains(1) || c.Len() != 2 {
+		t.Errorf("Remove(1) failed")
+	}
+	c.Add(6, 6)
+	c.Add(7, 7)
+	if c.Len() != 3 {
+		t.Errorf("c.Len() = %d, want 3", c.Len())
+	}
+	c.Purge()
+	if c.Len() != 0 {
+		t.Errorf("c.Len() after Purge = %d", c.Len())
+	}
+}
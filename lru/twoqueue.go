@@ -0,0 +1,167 @@
+package lru
+
+import (
+	list "github.com/andrewchambers/list-go"
+)
+
+// TwoQueueCache is a 2Q cache holding at most a fixed number of entries.
+// New keys enter a small FIFO queue (A1in); keys evicted from it are
+// remembered in a ghost queue (A1out), and only keys seen again while
+// remembered are promoted to the main LRU list (Am). Like ARC, this keeps
+// one-off accesses from flushing frequently used entries.
+// A TwoQueueCache is not safe for concurrent use.
+type TwoQueueCache[K comparable, V any] struct {
+	size     int
+	inSize   int // maximum length of a1in before it is reclaimed from
+	ghostCap int // maximum length of a1out
+	items    map[K]*list.Element[entry[K, V]]
+	a1in     list.List[entry[K, V]] // FIFO of keys seen once, newest at front
+	a1out    list.List[entry[K, V]] // ghost FIFO of keys evicted from a1in
+	am       list.List[entry[K, V]] // LRU of keys seen again, most recent at front
+	onEvict  func(K, V)
+	metrics  list.Metrics
+}
+
+// New2Q returns a 2Q cache holding at most size entries. A quarter of the
+// capacity is set aside for newly seen keys, and up to half the capacity of
+// evicted keys are remembered. If onEvict is not nil, it is called with each
+// entry evicted to make room for a new one. New2Q panics if size is not
+// positive.
+func New2Q[K comparable, V any](size int, onEvict func(K, V)) *TwoQueueCache[K, V] {
+	if size <= 0 {
+		panic("lru: size must be positive")
+	}
+	return &TwoQueueCache[K, V]{
+		size:     size,
+		inSize:   max(1, size/4),
+		ghostCap: max(1, size/2),
+		items:    make(map[K]*list.Element[entry[K, V]], size+size/2),
+		onEvict:  onEvict,
+	}
+}
+
+// resident returns the element for key if its value is cached.
+func (c *TwoQueueCache[K, V]) resident(key K) (*list.Element[entry[K, V]], bool) {
+	e, ok := c.items[key]
+	if !ok || e.List() == &c.a1out {
+		return nil, false
+	}
+	return e, true
+}
+
+// Get returns the value for key, marking it used if it is in the main list.
+func (c *TwoQueueCache[K, V]) Get(key K) (value V, ok bool) {
+	e, ok := c.resident(key)
+	if !ok {
+		return value, false
+	}
+	if e.List() == &c.am {
+		c.am.MoveToFront(e)
+	}
+	return e.Value.value, true
+}
+
+// Peek returns the value for key without updating its usage.
+func (c *TwoQueueCache[K, V]) Peek(key K) (value V, ok bool) {
+	e, ok := c.resident(key)
+	if !ok {
+		return value, false
+	}
+	return e.Value.value, true
+}
+
+// Contains reports whether key is in the cache without updating its usage.
+func (c *TwoQueueCache[K, V]) Contains(key K) bool {
+	_, ok := c.resident(key)
+	return ok
+}
+
+// Add sets the value for key and reports whether an entry was evicted to
+// make room.
+func (c *TwoQueueCache[K, V]) Add(key K, value V) (evicted bool) {
+	e, ok := c.items[key]
+	switch {
+	case ok && e.List() == &c.am:
+		e.Value.value = value
+		c.am.MoveToFront(e)
+		return false
+	case ok && e.List() == &c.a1in:
+		e.Value.value = value
+		return false
+	case ok: // remembered in a1out: promote to the main list
+		// Forget the ghost first so reclaiming cannot drop it.
+		c.a1out.Remove(e)
+		evicted = c.reclaim()
+		c.items[key] = c.am.PushFront(entry[K, V]{key, value})
+		return evicted
+	}
+	evicted = c.reclaim()
+	c.items[key] = c.a1in.PushFront(entry[K, V]{key, value})
+	return evicted
+}
+
+// reclaim evicts an entry if the cache is full and reports whether it did.
+func (c *TwoQueueCache[K, V]) reclaim() bool {
+	if c.a1in.Len()+c.am.Len() < c.size {
+		return false
+	}
+	if c.a1in.Len() > c.inSize || c.am.Len() == 0 {
+		e := c.a1in.Back()
+		ent := e.Value
+		var zero V
+		e.Value.value = zero // ghosts keep only the key
+		c.a1out.AdoptFront(e)
+		if c.a1out.Len() > c.ghostCap {
+			old, _ := c.a1out.PopBack()
+			delete(c.items, old.key)
+		}
+		c.evicted(ent)
+		return true
+	}
+	ent, _ := c.am.PopBack()
+	delete(c.items, ent.key)
+	c.evicted(ent)
+	return true
+}
+
+func (c *TwoQueueCache[K, V]) evicted(ent entry[K, V]) {
+	if c.metrics != nil {
+		c.metrics.AddEvictions(1)
+	}
+	if c.onEvict != nil {
+		c.onEvict(ent.key, ent.value)
+	}
+}
+
+// Remove removes key from the cache, including its ghost entry, and reports
+// whether its value was cached. The eviction callback is not called.
+func (c *TwoQueueCache[K, V]) Remove(key K) bool {
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	l := e.List()
+	delete(c.items, key)
+	l.Remove(e)
+	return l != &c.a1out
+}
+
+// Len returns the number of cached entries.
+func (c *TwoQueueCache[K, V]) Len() int { return c.a1in.Len() + c.am.Len() }
+
+// Purge removes all entries and ghost keys from the cache without calling
+// the eviction callback.
+func (c *TwoQueueCache[K, V]) Purge() {
+	clear(c.items)
+	c.a1in.Truncate(0)
+	c.a1out.Truncate(0)
+	c.am.Truncate(0)
+}
+
+// SetMetrics makes the cache report insertions, removals and moves of
+// resident entries, and evictions, to m, or stops reporting if m is nil.
+func (c *TwoQueueCache[K, V]) SetMetrics(m list.Metrics) {
+	c.metrics = m
+	c.a1in.SetMetrics(m)
+	c.am.SetMetrics(m)
+}
@@ -0,0 +1,86 @@
+package lru
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestTwoQueueCache(t *testing.T) {
+	var evicted []int
+	c := New2Q(4, func(k, v int) { evicted = append(evicted, k) })
+	for i := 0; i < 4; i++ {
+		c.Add(i, i)
+	}
+	// 0 is evicted from a1in into the ghost queue, then promoted on re-add.
+	c.Add(4, 4)
+	if c.Contains(0) {
+		t.Errorf("key 0 still cached after eviction")
+	}
+	c.Add(0, 100)
+	if v, ok := c.Get(0); !ok || v != 100 {
+		t.Errorf("c.Get(0) = %d, %v; want 100, true", v, ok)
+	}
+	if e := c.items[0]; e.List() != &c.am {
+		t.Errorf("re-added ghost key not promoted to the main list")
+	}
+
+	// A scan of new keys does not displace the promoted key.
+	for i := 10; i < 30; i++ {
+		c.Add(i, i)
+	}
+	if !c.Contains(0) {
+		t.Errorf("scan evicted the frequently used key")
+	}
+	if c.Len() != 4 {
+		t.Errorf("c.Len() = %d, want 4", c.Len())
+	}
+	if !c.Remove(0) || c.Contains(0) {
+		t.Errorf("Remove(0) failed")
+	}
+	c.Purge()
+	if c.Len() != 0 || len(c.items) != 0 {
+		t.Errorf("Purge left entries behind")
+	}
+}
+
+func TestTwoQueueCacheInvariants(t *testing.T) {
+	const size = 16
+	c := New2Q[int, int](size, nil)
+	r := rand.New(rand.NewSource(5))
+	for i := 0; i < 20000; i++ {
+		k := r.Intn(64)
+		if _, ok := c.Get(k); !ok {
+			c.Add(k, k)
+		}
+		if c.Len() > size || c.a1out.Len() > c.ghostCap {
+			t.Fatalf("step %d: |a1in|=%d |am|=%d |a1out|=%d exceed limits", i, c.a1in.Len(), c.am.Len(), c.a1out.Len())
+		}
+		for k, e := range c.items {
+			if e.List() == nil || e.Value.key != k {
+				t.Fatalf("step %d: stale map entry for key %d", i, k)
+			}
+		}
+		if len(c.items) != c.Len()+c.a1out.Len() {
+			t.Fatalf("step %d: %d map entries for %d list entries", i, len(c.items), c.Len()+c.a1out.Len())
+		}
+	}
+}
+
+func TestNewWithPolicy(t *testing.T) {
+	for _, p := range []Policy{LRU, ARC, TwoQueue, Sieve} {
+		evictions := 0
+		c := NewWithPolicy(p, 8, func(int, string) { evictions++ })
+		for i := 0; i < 20; i++ {
+			c.Add(i, "v")
+		}
+		if c.Len() != 8 {
+			t.Errorf("policy %d: Len() = %d, want 8", p, c.Len())
+		}
+		if evictions != 12 {
+			t.Errorf("policy %d: %d evictions, want 12", p, evictions)
+		}
+		if v, ok := c.Peek(19); !ok || v != "v" {
+			t.Errorf("policy %d: Peek(19) = %q, %v", p, v, ok)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package list
+
+import "sync/atomic"
+
+// Metrics receives counts of list operations, so that lists and the types
+// built on them can be observed in production. Implementations typically
+// forward the counts to expvar or Prometheus counters; Counters is a
+// ready-made implementation. Metrics used by lists in different
+// goroutines must be safe for concurrent use.
+type Metrics interface {
+	AddInserts(n int)   // elements inserted
+	AddRemoves(n int)   // elements removed
+	AddMoves(n int)     // elements moved within a list
+	AddEvictions(n int) // values evicted by a bounded type or cache
+}
+
+// Counters is a Metrics implementation that accumulates counts in atomic
+// counters. For example, to publish them with expvar:
+//
+//	var c list.Counters
+//	l.SetMetrics(&c)
+//	expvar.Publish("queue_inserts", expvar.Func(func() any { return c.Inserts.Load() }))
+type Counters struct {
+	Inserts, Removes, Moves, Evictions atomic.Uint64
+}
+
+func (c *Counters) AddInserts(n int)   { c.Inserts.Add(uint64(n)) }
+func (c *Counters) AddRemoves(n int)   { c.Removes.Add(uint64(n)) }
+func (c *Counters) AddMoves(n int)     { c.Moves.Add(uint64(n)) }
+func (c *Counters) AddEvictions(n int) { c.Evictions.Add(uint64(n)) }
+
+// SetMetrics makes list l report its operations to m, or stops reporting
+// if m is nil. Elements spliced from another list count as removes there
+// and inserts in l; reordering operations such as Sort, Reverse and
+// Rotate are not counted.
+func (l *List[E]) SetMetrics(m Metrics) { l.metrics = m }
+
+func (l *List[E]) countInserts(n int) {
+	if l.metrics != nil {
+		l.metrics.AddInserts(n)
+	}
+}
+
+func (l *List[E]) countRemoves(n int) {
+	if l.metrics != nil {
+		l.metrics.AddRemoves(n)
+	}
+}
+
+func (l *List[E]) countMoves(n int) {
+	if l.metrics != nil {
+		l.metrics.AddMoves(n)
+	}
+}
@@ -0,0 +1,40 @@
+package list
+
+import "testing"
+
+func TestMetrics(t *testing.T) {
+	var c Counters
+	l := New[int]()
+	l.SetMetrics(&c)
+	l.PushBackMany(1, 2, 3, 4)
+	l.MoveToFront(l.Back())
+	l.MoveToFront(l.Front()) // no-op, not counted
+	l.Remove(l.Front())
+
+	other := New[int]()
+	other.PushBackMany(5, 6)
+	l.PushBackList(other) // copies, counted as inserts
+	l.MoveRangeBefore(l.Back().Prev(), l.Back(), l.Front())
+	rest := l.SplitBefore(l.Back())
+	l.Init()
+
+	// 4+2 inserts; 1 move, 2 moved as a range; 1 removed, 1 split off and
+	// the remaining 4 dropped by Init.
+	if got := [3]uint64{c.Inserts.Load(), c.Moves.Load(), c.Removes.Load()}; got != [3]uint64{6, 3, 6} {
+		t.Errorf("inserts, moves, removes = %v, want [6 3 6]", got)
+	}
+	if rest.Len() != 1 {
+		t.Errorf("rest.Len() = %d, want 1", rest.Len())
+	}
+
+	var bc Counters
+	b := NewBounded[int](2, nil)
+	b.SetMetrics(&bc)
+	for i := range 5 {
+		b.PushBack(i)
+	}
+	if bc.Evictions.Load() != 3 || bc.Inserts.Load() != 5 || bc.Removes.Load() != 3 {
+		t.Errorf("bounded evictions, inserts, removes = %d, %d, %d; want 3, 5, 3",
+			bc.Evictions.Load(), bc.Inserts.Load(), bc.Removes.Load())
+	}
+}
@@ -0,0 +1,86 @@
+package list
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// MPSCQueue is an unbounded lock-free multi-producer single-consumer FIFO
+// queue, suitable for actor mailboxes and similar fan-in workloads.
+// Push may be called from any number of goroutines concurrently; Pop must
+// only be called from a single consumer goroutine at a time.
+//
+// Push is lock-free and Pop is wait-free. Like the element pool of List,
+// queue nodes are recycled once popped so steady-state traffic does not
+// allocate. An MPSCQueue must be created with NewMPSCQueue and must not be
+// copied after first use.
+type MPSCQueue[E any] struct {
+	head atomic.Pointer[mpscNode[E]] // most recently pushed node, shared by producers
+	tail *mpscNode[E]                // next node to pop, owned by the consumer
+	stub mpscNode[E]
+	pool sync.Pool
+}
+
+type mpscNode[E any] struct {
+	next  atomic.Pointer[mpscNode[E]]
+	value E
+}
+
+// NewMPSCQueue returns an empty queue.
+func NewMPSCQueue[E any]() *MPSCQueue[E] {
+	q := &MPSCQueue[E]{}
+	q.head.Store(&q.stub)
+	q.tail = &q.stub
+	q.pool.New = func() any { return new(mpscNode[E]) }
+	return q
+}
+
+// Push appends v to the back of q. It is safe for concurrent use.
+func (q *MPSCQueue[E]) Push(v E) {
+	n := q.pool.Get().(*mpscNode[E])
+	n.value = v
+	q.push(n)
+}
+
+func (q *MPSCQueue[E]) push(n *mpscNode[E]) {
+	n.next.Store(nil)
+	prev := q.head.Swap(n)
+	// Between the swap and this store the queue is briefly disconnected;
+	// Pop treats that window as the queue being empty.
+	prev.next.Store(n)
+}
+
+// Pop removes and returns the value at the front of q. If q is empty, or
+// the only pending Push has not finished linking its value, Pop returns the
+// zero value and false. Pop must not be called concurrently with itself.
+func (q *MPSCQueue[E]) Pop() (E, bool) {
+	var zero E
+	tail := q.tail
+	next := tail.next.Load()
+	if tail == &q.stub {
+		if next == nil {
+			return zero, false
+		}
+		q.tail = next
+		tail = next
+		next = next.next.Load()
+	}
+	if next == nil {
+		if tail != q.head.Load() {
+			// A producer has swapped in a new head but not linked it yet.
+			return zero, false
+		}
+		// tail is the last node; re-insert the stub behind it so tail can
+		// be handed out without leaving the queue without a node.
+		q.push(&q.stub)
+		next = tail.next.Load()
+		if next == nil {
+			return zero, false
+		}
+	}
+	q.tail = next
+	v := tail.value
+	tail.value = zero // avoid memory leaks
+	q.pool.Put(tail)
+	return v, true
+}
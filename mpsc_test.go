@@ -0,0 +1,57 @@
+package list
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestMPSCQueue(t *testing.T) {
+	q := NewMPSCQueue[int]()
+	if _, ok := q.Pop(); ok {
+		t.Fatalf("Pop on empty queue succeeded")
+	}
+	for i := 0; i < 5; i++ {
+		q.Push(i)
+	}
+	for i := 0; i < 5; i++ {
+		if v, ok := q.Pop(); !ok || v != i {
+			t.Fatalf("q.Pop() = %d, %v; want %d, true", v, ok, i)
+		}
+	}
+	if _, ok := q.Pop(); ok {
+		t.Fatalf("Pop on drained queue succeeded")
+	}
+}
+
+func TestMPSCQueueConcurrent(t *testing.T) {
+	const producers, perProducer = 8, 2000
+	type msg struct{ producer, seq int }
+	q := NewMPSCQueue[msg]()
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				q.Push(msg{p, i})
+			}
+		}()
+	}
+
+	next := make([]int, producers)
+	for got := 0; got < producers*perProducer; {
+		m, ok := q.Pop()
+		if !ok {
+			continue
+		}
+		if m.seq != next[m.producer] {
+			t.Fatalf("producer %d: got seq %d, want %d", m.producer, m.seq, next[m.producer])
+		}
+		next[m.producer]++
+		got++
+	}
+	wg.Wait()
+	if _, ok := q.Pop(); ok {
+		t.Errorf("queue not empty after consuming every message")
+	}
+}
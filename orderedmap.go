@@ -0,0 +1,143 @@
+package list
+
+import "iter"
+
+// OrderedMap is a map that remembers the order in which keys were inserted.
+// Lookups are O(1) and iteration follows insertion order, which can be
+// changed with MoveToFront and MoveToBack.
+// The zero value is an empty map ready to use.
+// An OrderedMap is not safe for concurrent use.
+type OrderedMap[K comparable, V any] struct {
+	items map[K]*Element[orderedEntry[K, V]]
+	order List[orderedEntry[K, V]]
+}
+
+type orderedEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// NewOrderedMap returns an empty ordered map.
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return new(OrderedMap[K, V])
+}
+
+// Len returns the number of entries of m.
+func (m *OrderedMap[K, V]) Len() int { return m.order.Len() }
+
+// Get returns the value for key and whether it was present.
+func (m *OrderedMap[K, V]) Get(key K) (value V, ok bool) {
+	e, ok := m.items[key]
+	if !ok {
+		return value, false
+	}
+	return e.Value.value, true
+}
+
+// Contains reports whether key is present in m.
+func (m *OrderedMap[K, V]) Contains(key K) bool {
+	_, ok := m.items[key]
+	return ok
+}
+
+// Set sets the value for key. A new key is added at the back of m; an
+// existing key keeps its position. Set reports whether key was new.
+func (m *OrderedMap[K, V]) Set(key K, value V) (added bool) {
+	if e, ok := m.items[key]; ok {
+		e.Value.value = value
+		return false
+	}
+	if m.items == nil {
+		m.items = make(map[K]*Element[orderedEntry[K, V]])
+	}
+	m.items[key] = m.order.PushBack(orderedEntry[K, V]{key, value})
+	return true
+}
+
+// Delete removes key from m and returns its value and whether it was
+// present.
+func (m *OrderedMap[K, V]) Delete(key K) (value V, ok bool) {
+	e, ok := m.items[key]
+	if !ok {
+		return value, false
+	}
+	delete(m.items, key)
+	return m.order.Remove(e).value, true
+}
+
+// MoveToFront moves key to the front of m and reports whether it was
+// present.
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+	e, ok := m.items[key]
+	if ok {
+		m.order.MoveToFront(e)
+	}
+	return ok
+}
+
+// MoveToBack moves key to the back of m and reports whether it was
+// present.
+func (m *OrderedMap[K, V]) MoveToBack(key K) bool {
+	e, ok := m.items[key]
+	if ok {
+		m.order.MoveToBack(e)
+	}
+	return ok
+}
+
+// Front returns the first key of m and its value, or ok == false if m is
+// empty.
+func (m *OrderedMap[K, V]) Front() (key K, value V, ok bool) {
+	if e := m.order.Front(); e != nil {
+		return e.Value.key, e.Value.value, true
+	}
+	return key, value, false
+}
+
+// Back returns the last key of m and its value, or ok == false if m is
+// empty.
+func (m *OrderedMap[K, V]) Back() (key K, value V, ok bool) {
+	if e := m.order.Back(); e != nil {
+		return e.Value.key, e.Value.value, true
+	}
+	return key, value, false
+}
+
+// Clear removes all entries from m.
+func (m *OrderedMap[K, V]) Clear() {
+	clear(m.items)
+	m.order.Truncate(0)
+}
+
+// All returns an iterator over the key-value pairs of m in order.
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for ent := range m.order.All() {
+			if !yield(ent.key, ent.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the keys of m in order.
+func (m *OrderedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for ent := range m.order.All() {
+			if !yield(ent.key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values of m in order.
+func (m *OrderedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for ent := range m.order.All() {
+			if !yield(ent.value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestOrderedMap(t *testing.T) {
+	var m OrderedMap[string, int]
+	if _, _, ok := m.Front(); ok {
+		t.Errorf("Front of empty map ok")
+	}
+	for i, k := range []string{"c", "a", "b"} {
+		if !m.Set(k, i) {
+			t.Errorf("m.Set(%q) reported existing key", k)
+		}
+	}
+	if m.Set("a", 10) {
+		t.Errorf("m.Set(\"a\") reported new key")
+	}
+	if got, want := slices.Collect(m.Keys()), []string{"c", "a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("m.Keys() = %v, want %v", got, want)
+	}
+	if v, ok := m.Get("a"); !ok || v != 10 {
+		t.Errorf("m.Get(\"a\") = %d, %v; want 10, true", v, ok)
+	}
+	if _, ok := m.Get("z"); ok || m.Contains("z") {
+		t.Errorf("missing key reported present")
+	}
+
+	m.MoveToFront("b")
+	m.MoveToBack("c")
+	if m.MoveToFront("z") {
+		t.Errorf("m.MoveToFront(\"z\") = true")
+	}
+	if got, want := slices.Collect(m.Values()), []int{2, 10, 0}; !slices.Equal(got, want) {
+		t.Errorf("m.Values() = %v, want %v", got, want)
+	}
+	if k, v, ok := m.Front(); !ok || k != "b" || v != 2 {
+		t.Errorf("m.Front() = %q, %d, %v", k, v, ok)
+	}
+	if k, v, ok := m.Back(); !ok || k != "c" || v != 0 {
+		t.Errorf("m.Back() = %q, %d, %v", k, v, ok)
+	}
+
+	if v, ok := m.Delete("a"); !ok || v != 10 || m.Len() != 2 {
+		t.Errorf("m.Delete(\"a\") = %d, %v; len %d", v, ok, m.Len())
+	}
+	if _, ok := m.Delete("a"); ok {
+		t.Errorf("second Delete reported present")
+	}
+	var keys []string
+	for k, v := range m.All() {
+		keys = append(keys, k)
+		if got, _ := m.Get(k); got != v {
+			t.Errorf("All yielded %q=%d, Get = %d", k, v, got)
+		}
+	}
+	if want := []string{"b", "c"}; !slices.Equal(keys, want) {
+		t.Errorf("m.All() keys = %v, want %v", keys, want)
+	}
+	m.Clear()
+	if m.Len() != 0 || m.Contains("b") {
+		t.Errorf("m not empty after Clear")
+	}
+}
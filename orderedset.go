@@ -0,0 +1,100 @@
+package list
+
+import "iter"
+
+// OrderedSet is a set that remembers the order in which values were added.
+// Add, Remove and Contains are O(1) and iteration follows insertion order.
+// The zero value is an empty set ready to use.
+// An OrderedSet is not safe for concurrent use.
+type OrderedSet[E comparable] struct {
+	items map[E]*Element[E]
+	order List[E]
+}
+
+// NewOrderedSet returns an ordered set holding the distinct values of vs
+// in the order of their first occurrence.
+func NewOrderedSet[E comparable](vs ...E) *OrderedSet[E] {
+	s := new(OrderedSet[E])
+	for _, v := range vs {
+		s.Add(v)
+	}
+	return s
+}
+
+// Len returns the number of values in s.
+func (s *OrderedSet[E]) Len() int { return s.order.Len() }
+
+// Contains reports whether v is in s.
+func (s *OrderedSet[E]) Contains(v E) bool {
+	_, ok := s.items[v]
+	return ok
+}
+
+// Add adds v at the back of s and reports whether it was not already
+// present. A value already in s keeps its position.
+func (s *OrderedSet[E]) Add(v E) bool {
+	if _, ok := s.items[v]; ok {
+		return false
+	}
+	if s.items == nil {
+		s.items = make(map[E]*Element[E])
+	}
+	s.items[v] = s.order.PushBack(v)
+	return true
+}
+
+// Remove removes v from s and reports whether it was present.
+func (s *OrderedSet[E]) Remove(v E) bool {
+	e, ok := s.items[v]
+	if ok {
+		delete(s.items, v)
+		s.order.Remove(e)
+	}
+	return ok
+}
+
+// Clear removes all values from s.
+func (s *OrderedSet[E]) Clear() {
+	clear(s.items)
+	s.order.Truncate(0)
+}
+
+// All returns an iterator over the values of s in order.
+func (s *OrderedSet[E]) All() iter.Seq[E] { return s.order.All() }
+
+// Union returns a new set holding the values of s in order followed by the
+// values of other that are not in s, in their order in other.
+func (s *OrderedSet[E]) Union(other *OrderedSet[E]) *OrderedSet[E] {
+	u := new(OrderedSet[E])
+	for v := range s.All() {
+		u.Add(v)
+	}
+	for v := range other.All() {
+		u.Add(v)
+	}
+	return u
+}
+
+// Intersection returns a new set holding the values of s that are also in
+// other, in their order in s.
+func (s *OrderedSet[E]) Intersection(other *OrderedSet[E]) *OrderedSet[E] {
+	n := new(OrderedSet[E])
+	for v := range s.All() {
+		if other.Contains(v) {
+			n.Add(v)
+		}
+	}
+	return n
+}
+
+// Difference returns a new set holding the values of s that are not in
+// other, in their order in s.
+func (s *OrderedSet[E]) Difference(other *OrderedSet[E]) *OrderedSet[E] {
+	d := new(OrderedSet[E])
+	for v := range s.All() {
+		if !other.Contains(v) {
+			d.Add(v)
+		}
+	}
+	return d
+}
@@ -0,0 +1,47 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestOrderedSet(t *testing.T) {
+	s := NewOrderedSet(3, 1, 3, 2)
+	if got, want := slices.Collect(s.All()), []int{3, 1, 2}; !slices.Equal(got, want) {
+		t.Errorf("s.All() = %v, want %v", got, want)
+	}
+	if s.Add(1) || !s.Add(5) || s.Len() != 4 {
+		t.Errorf("Add results wrong, len %d", s.Len())
+	}
+	if !s.Remove(1) || s.Remove(1) || s.Contains(1) || !s.Contains(5) {
+		t.Errorf("Remove or Contains results wrong")
+	}
+	if got, want := slices.Collect(s.All()), []int{3, 2, 5}; !slices.Equal(got, want) {
+		t.Errorf("s.All() = %v, want %v", got, want)
+	}
+
+	o := NewOrderedSet(5, 7, 3, 8)
+	tests := []struct {
+		name string
+		got  *OrderedSet[int]
+		want []int
+	}{
+		{"Union", s.Union(o), []int{3, 2, 5, 7, 8}},
+		{"Intersection", s.Intersection(o), []int{3, 5}},
+		{"Difference", s.Difference(o), []int{2}},
+	}
+	for _, tt := range tests {
+		if got := slices.Collect(tt.got.All()); !slices.Equal(got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	var z OrderedSet[int]
+	if z.Contains(1) || z.Remove(1) || z.Len() != 0 {
+		t.Errorf("zero OrderedSet not empty")
+	}
+	s.Clear()
+	if s.Len() != 0 || s.Contains(3) {
+		t.Errorf("s not empty after Clear")
+	}
+}
@@ -0,0 +1,155 @@
+package list
+
+// PriorityQueue is a binary min-heap ordered by a comparison function: Pop
+// returns the smallest value. Items returned by Push can be passed to Fix
+// after their Value changes, or to Remove. Like List, a PriorityQueue keeps a
+// small pool of removed items for reuse, so an item must not be used after it
+// has been popped or removed.
+type PriorityQueue[E any] struct {
+	cmp   func(a, b E) int
+	heap  []*PQItem[E]
+	ipool []*PQItem[E]
+}
+
+// PQItem is a value held in a PriorityQueue.
+type PQItem[E any] struct {
+	// The value stored with this item. Call Fix after changing it.
+	Value E
+
+	index int // position in the heap, or -1 once removed
+}
+
+// NewPriorityQueue returns an empty priority queue ordered by cmp.
+// cmp(a, b) should return a negative number when a < b, a positive number
+// when a > b and zero when a == b.
+func NewPriorityQueue[E any](cmp func(a, b E) int) *PriorityQueue[E] {
+	return &PriorityQueue[E]{cmp: cmp}
+}
+
+// Len returns the number of items in q.
+func (q *PriorityQueue[E]) Len() int { return len(q.heap) }
+
+// Push adds v to q and returns its item. The complexity is O(log n).
+func (q *PriorityQueue[E]) Push(v E) *PQItem[E] {
+	var it *PQItem[E]
+	if n := len(q.ipool); n > 0 {
+		it = q.ipool[n-1]
+		q.ipool = q.ipool[:n-1]
+	} else {
+		it = new(PQItem[E])
+	}
+	it.Value = v
+	it.index = len(q.heap)
+	q.heap = append(q.heap, it)
+	q.up(it.index)
+	return it
+}
+
+// Peek returns the smallest value in q without removing it.
+// If q is empty, Peek returns the zero value and false.
+func (q *PriorityQueue[E]) Peek() (E, bool) {
+	if len(q.heap) == 0 {
+		var zero E
+		return zero, false
+	}
+	return q.heap[0].Value, true
+}
+
+// Pop removes and returns the smallest value in q.
+// If q is empty, Pop returns the zero value and false.
+// The complexity is O(log n).
+func (q *PriorityQueue[E]) Pop() (E, bool) {
+	if len(q.heap) == 0 {
+		var zero E
+		return zero, false
+	}
+	return q.Remove(q.heap[0]), true
+}
+
+// Fix restores the heap ordering after the Value of it has changed.
+// If it is not in q, Fix does nothing. The complexity is O(log n).
+func (q *PriorityQueue[E]) Fix(it *PQItem[E]) {
+	if !q.contains(it) {
+		return
+	}
+	if !q.down(it.index) {
+		q.up(it.index)
+	}
+}
+
+// Remove removes it from q if it is in q and returns it.Value.
+// The item must not be used afterwards. The complexity is O(log n).
+func (q *PriorityQueue[E]) Remove(it *PQItem[E]) E {
+	v := it.Value
+	if !q.contains(it) {
+		return v
+	}
+	i, last := it.index, len(q.heap)-1
+	if i != last {
+		q.swap(i, last)
+	}
+	q.heap[last] = nil
+	q.heap = q.heap[:last]
+	if i != last && !q.down(i) {
+		q.up(i)
+	}
+	it.index = -1
+	q.poolItem(it)
+	return v
+}
+
+func (q *PriorityQueue[E]) contains(it *PQItem[E]) bool {
+	return it.index >= 0 && it.index < len(q.heap) && q.heap[it.index] == it
+}
+
+func (q *PriorityQueue[E]) poolItem(it *PQItem[E]) {
+	const poolSize = 4
+	if len(q.ipool) == poolSize {
+		return
+	}
+	var zero E
+	it.Value = zero // avoid memory leaks
+	q.ipool = append(q.ipool, it)
+}
+
+func (q *PriorityQueue[E]) less(i, j int) bool {
+	return q.cmp(q.heap[i].Value, q.heap[j].Value) < 0
+}
+
+func (q *PriorityQueue[E]) swap(i, j int) {
+	q.heap[i], q.heap[j] = q.heap[j], q.heap[i]
+	q.heap[i].index = i
+	q.heap[j].index = j
+}
+
+func (q *PriorityQueue[E]) up(j int) {
+	for j > 0 {
+		i := (j - 1) / 2 // parent
+		if !q.less(j, i) {
+			break
+		}
+		q.swap(i, j)
+		j = i
+	}
+}
+
+// down moves the item at i0 down the heap and reports whether it moved.
+func (q *PriorityQueue[E]) down(i0 int) bool {
+	n := len(q.heap)
+	i := i0
+	for {
+		j := 2*i + 1
+		if j >= n {
+			break
+		}
+		if r := j + 1; r < n && q.less(r, j) {
+			j = r
+		}
+		if !q.less(j, i) {
+			break
+		}
+		q.swap(i, j)
+		i = j
+	}
+	return i > i0
+}
@@ -0,0 +1,58 @@
+package list
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	q := NewPriorityQueue(cmp.Compare[int])
+	if _, ok := q.Pop(); ok {
+		t.Fatalf("Pop on empty queue succeeded")
+	}
+	r := rand.New(rand.NewSource(3))
+	var want []int
+	for i := 0; i < 100; i++ {
+		v := r.Intn(1000)
+		want = append(want, v)
+		q.Push(v)
+	}
+	slices.Sort(want)
+	if v, ok := q.Peek(); !ok || v != want[0] {
+		t.Errorf("q.Peek() = %d, %v; want %d, true", v, ok, want[0])
+	}
+	var got []int
+	for q.Len() > 0 {
+		v, _ := q.Pop()
+		got = append(got, v)
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("popped %v, want %v", got, want)
+	}
+}
+
+func TestPriorityQueueFixRemove(t *testing.T) {
+	q := NewPriorityQueue(cmp.Compare[int])
+	items := make([]*PQItem[int], 10)
+	for i := range items {
+		items[i] = q.Push(i * 10)
+	}
+	items[9].Value = -1
+	q.Fix(items[9])
+	items[0].Value = 55
+	q.Fix(items[0])
+	if v := q.Remove(items[5]); v != 50 {
+		t.Errorf("q.Remove(items[5]) = %d, want 50", v)
+	}
+	q.Remove(items[5]) // no longer in q
+	var got []int
+	for q.Len() > 0 {
+		v, _ := q.Pop()
+		got = append(got, v)
+	}
+	if want := []int{-1, 10, 20, 30, 40, 55, 60, 70, 80}; !slices.Equal(got, want) {
+		t.Errorf("popped %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,26 @@
+package list
+
+// Queue is a first-in, first-out queue backed by a List. It exposes only
+// queue operations, so it can be handed to code that should not reorder
+// or otherwise modify the underlying list.
+// The zero value is an empty queue ready to use.
+type Queue[E any] struct {
+	list List[E]
+}
+
+// NewQueue returns an empty queue.
+func NewQueue[E any]() *Queue[E] { return new(Queue[E]) }
+
+// Len returns the number of values in q.
+func (q *Queue[E]) Len() int { return q.list.Len() }
+
+// Enqueue adds v to the back of q.
+func (q *Queue[E]) Enqueue(v E) { q.list.PushBack(v) }
+
+// Dequeue removes and returns the value at the front of q, or ok == false
+// if q is empty.
+func (q *Queue[E]) Dequeue() (v E, ok bool) { return q.list.PopFront() }
+
+// Peek returns the value at the front of q without removing it, or
+// ok == false if q is empty.
+func (q *Queue[E]) Peek() (v E, ok bool) { return q.list.FrontValue() }
@@ -0,0 +1,27 @@
+package list
+
+import "testing"
+
+func TestQueue(t *testing.T) {
+	var q Queue[int]
+	if _, ok := q.Dequeue(); ok {
+		t.Errorf("Dequeue on empty queue ok")
+	}
+	if _, ok := q.Peek(); ok {
+		t.Errorf("Peek on empty queue ok")
+	}
+	for i := range 3 {
+		q.Enqueue(i)
+	}
+	if v, ok := q.Peek(); !ok || v != 0 || q.Len() != 3 {
+		t.Errorf("q.Peek() = %d, %v; len %d", v, ok, q.Len())
+	}
+	for want := range 3 {
+		if v, ok := q.Dequeue(); !ok || v != want {
+			t.Errorf("q.Dequeue() = %d, %v; want %d, true", v, ok, want)
+		}
+	}
+	if q.Len() != 0 {
+		t.Errorf("q.Len() = %d, want 0", q.Len())
+	}
+}
@@ -0,0 +1,89 @@
+package list
+
+import "iter"
+
+// RankedList is a sorted list augmented with order statistics. It keeps
+// the skip-list index used by IndexedList, whose links count the elements
+// they span, so that Insert, Rank and Kth take O(log n) expected time.
+// Values that compare equal are kept in insertion order.
+//
+// Elements returned by a RankedList belong to an internal List. Callers
+// may traverse them with Next and Prev but must not modify their Value or
+// move them, as that would break the ordering.
+// A RankedList must be created with NewRankedList.
+type RankedList[E any] struct {
+	list List[E]
+	idx  skipIndex[E]
+	cmp  func(a, b E) int
+}
+
+// NewRankedList returns an empty ranked list ordered by cmp.
+// cmp(a, b) should return a negative number when a < b, a positive number
+// when a > b and zero when a == b.
+func NewRankedList[E any](cmp func(a, b E) int) *RankedList[E] {
+	r := &RankedList[E]{cmp: cmp}
+	r.list.Init()
+	r.idx.init()
+	return r
+}
+
+// Len returns the number of elements of r.
+func (r *RankedList[E]) Len() int { return r.list.Len() }
+
+// Front returns the smallest element of r or nil if r is empty.
+func (r *RankedList[E]) Front() *Element[E] { return r.list.Front() }
+
+// Back returns the largest element of r or nil if r is empty.
+func (r *RankedList[E]) Back() *Element[E] { return r.list.Back() }
+
+// Insert inserts a new element with value v at its ordered position in r,
+// after any values that compare equal, and returns it.
+func (r *RankedList[E]) Insert(v E) *Element[E] {
+	var p skipPath[E]
+	r.idx.search(&p, func(n *skipNode[E], _ int) bool { return r.cmp(n.elem.Value, v) <= 0 })
+	at := &r.list.root
+	if p.node[0] != &r.idx.head {
+		at = p.node[0].elem
+	}
+	e := r.list.insertValue(v, at)
+	r.idx.insert(&p, e)
+	return e
+}
+
+// Remove removes e from r if e is an element of r and returns e.Value.
+// The element must not be nil.
+func (r *RankedList[E]) Remove(e *Element[E]) E {
+	if e.list == &r.list {
+		r.idx.remove(e)
+	}
+	return r.list.Remove(e)
+}
+
+// Rank returns the number of elements of r ordered before e, or -1 if e
+// is not an element of r.
+func (r *RankedList[E]) Rank(e *Element[E]) int {
+	if e == nil || e.list != &r.list {
+		return -1
+	}
+	return r.idx.rank(r.idx.nodes[e])
+}
+
+// Kth returns the element of rank k, the k'th smallest counting from 0, or
+// nil if k is out of range.
+func (r *RankedList[E]) Kth(k int) *Element[E] {
+	if k < 0 || k >= r.list.Len() {
+		return nil
+	}
+	return r.idx.at(k).elem
+}
+
+// CountLess returns the number of elements of r whose value is less than
+// v.
+func (r *RankedList[E]) CountLess(v E) int {
+	var p skipPath[E]
+	r.idx.search(&p, func(n *skipNode[E], _ int) bool { return r.cmp(n.elem.Value, v) < 0 })
+	return p.rank[0] + 1
+}
+
+// All returns an iterator over the values of r in ascending order.
+func (r *RankedList[E]) All() iter.Seq[E] { return r.list.All() }
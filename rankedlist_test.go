@@ -0,0 +1,54 @@
+package list
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestRankedList(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(5, 6))
+	r := NewRankedList(func(a, b sortItem) int { return cmp.Compare(a.key, b.key) })
+	var want []sortItem
+	for seq := range 1000 {
+		it := sortItem{key: rnd.IntN(100), seq: seq}
+		r.Insert(it)
+		i, _ := slices.BinarySearchFunc(want, it.key+1, func(a sortItem, k int) int { return cmp.Compare(a.key, k) })
+		want = slices.Insert(want, i, it)
+		if seq%3 == 0 {
+			k := rnd.IntN(len(want))
+			if got := r.Remove(r.Kth(k)); got != want[k] {
+				t.Fatalf("r.Remove(Kth(%d)) = %v, want %v", k, got, want[k])
+			}
+			want = slices.Delete(want, k, k+1)
+		}
+	}
+	if got := slices.Collect(r.All()); !slices.Equal(got, want) {
+		t.Fatalf("r.All() is not sorted and stable")
+	}
+	for k, it := range want {
+		e := r.Kth(k)
+		if e == nil || e.Value != it {
+			t.Fatalf("r.Kth(%d) = %v, want %v", k, e, it)
+		}
+		if got := r.Rank(e); got != k {
+			t.Fatalf("r.Rank(Kth(%d)) = %d", k, got)
+		}
+	}
+	for key := range 101 {
+		n := 0
+		for n < len(want) && want[n].key < key {
+			n++
+		}
+		if got := r.CountLess(sortItem{key: key}); got != n {
+			t.Errorf("r.CountLess(%d) = %d, want %d", key, got, n)
+		}
+	}
+	if r.Kth(-1) != nil || r.Kth(r.Len()) != nil || r.Rank(new(Element[sortItem])) != -1 {
+		t.Errorf("out of range queries returned results")
+	}
+	if r.Front().Value != want[0] || r.Back().Value != want[len(want)-1] {
+		t.Errorf("Front or Back wrong")
+	}
+}
@@ -0,0 +1,107 @@
+package list
+
+import "math/rand/v2"
+
+// Reverse reverses the order of the elements of list l in place.
+// Elements are relinked, not reallocated, so element pointers remain valid.
+func (l *List[E]) Reverse() {
+	if l.len < 2 {
+		return
+	}
+	if l.hooks != nil {
+		l.hooks.reordering(l)
+	}
+	l.mod++
+	e := &l.root
+	for {
+		e.next, e.prev = e.prev, e.next
+		e = e.prev // the old next
+		if e == &l.root {
+			break
+		}
+	}
+	if l.hooks != nil {
+		l.hooks.reordered(l)
+	}
+}
+
+// Swap exchanges the positions of elements a and b in list l.
+// If a or b is not an element of l, or a == b, the list is not modified.
+// The elements must not be nil.
+func (l *List[E]) Swap(a, b *Element[E]) {
+	if !l.isElem("Swap", a, l) || !l.isElem("Swap", b, l) || a == b {
+		return
+	}
+	switch {
+	case a.next == b:
+		l.move(a, b)
+	case b.next == a:
+		l.move(b, a)
+	default:
+		prev := a.prev
+		l.move(a, b)
+		l.move(b, prev)
+	}
+}
+
+// Rotate rotates list l left by n positions, so that the element at position
+// n becomes the front. A negative n rotates right. Rotation relinks the
+// sentinel in O(min(n, l.Len()-n)) steps without allocating.
+func (l *List[E]) Rotate(n int) {
+	if l.len < 2 {
+		return
+	}
+	n %= l.len
+	if n < 0 {
+		n += l.len
+	}
+	if n == 0 {
+		return
+	}
+	var front *Element[E]
+	if n <= l.len/2 {
+		for front = l.root.next; n > 0; n-- {
+			front = front.next
+		}
+	} else {
+		for front, n = l.root.prev, l.len-1-n; n > 0; n-- {
+			front = front.prev
+		}
+	}
+	if l.hooks != nil {
+		l.hooks.reordering(l)
+	}
+	l.mod++
+	root := &l.root
+	root.prev.next = root.next
+	root.next.prev = root.prev
+	root.next = front
+	root.prev = front.prev
+	front.prev.next = root
+	front.prev = root
+	if l.hooks != nil {
+		l.hooks.reordered(l)
+	}
+}
+
+// Shuffle pseudo-randomly permutes the elements of list l using r.
+// Elements are relinked, not reallocated, so element pointers remain valid.
+// Shuffle allocates a temporary slice of l.Len() element pointers.
+func (l *List[E]) Shuffle(r *rand.Rand) {
+	if l.len < 2 {
+		return
+	}
+	es := make([]*Element[E], 0, l.len)
+	for e := l.root.next; e != &l.root; e = e.next {
+		es = append(es, e)
+	}
+	if l.hooks != nil {
+		l.hooks.reordering(l)
+	}
+	r.Shuffle(len(es), func(i, j int) { es[i], es[j] = es[j], es[i] })
+	for i, e := range es[:len(es)-1] {
+		e.next = es[i+1]
+	}
+	es[len(es)-1].next = nil
+	l.relinkChain(es[0])
+}
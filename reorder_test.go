@@ -0,0 +1,96 @@
+package list
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestReverse(t *testing.T) {
+	var z List[int]
+	z.Reverse()
+	checkListPointers(t, &z, []*Element[int]{})
+
+	l := New[int]()
+	e1 := l.PushBack(1)
+	l.Reverse()
+	checkListPointers(t, l, []*Element[int]{e1})
+
+	e2 := l.PushBack(2)
+	e3 := l.PushBack(3)
+	l.Reverse()
+	checkListPointers(t, l, []*Element[int]{e3, e2, e1})
+	l.Reverse()
+	checkListPointers(t, l, []*Element[int]{e1, e2, e3})
+}
+
+func TestSwap(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+	e3 := l.PushBack(3)
+	e4 := l.PushBack(4)
+
+	l.Swap(e2, e2)
+	checkListPointers(t, l, []*Element[int]{e1, e2, e3, e4})
+	l.Swap(e1, e2) // adjacent
+	checkListPointers(t, l, []*Element[int]{e2, e1, e3, e4})
+	l.Swap(e3, e1) // adjacent, reversed arguments
+	checkListPointers(t, l, []*Element[int]{e2, e3, e1, e4})
+	l.Swap(e2, e4) // front and back
+	checkListPointers(t, l, []*Element[int]{e4, e3, e1, e2})
+	l.Swap(e3, e2)
+	checkListPointers(t, l, []*Element[int]{e4, e2, e1, e3})
+
+	other := New[int]()
+	o := other.PushBack(5)
+	l.Swap(e1, o)
+	checkListPointers(t, l, []*Element[int]{e4, e2, e1, e3})
+	checkListPointers(t, other, []*Element[int]{o})
+}
+
+func TestRotate(t *testing.T) {
+	l := New[int]()
+	es := []*Element[int]{l.PushBack(0), l.PushBack(1), l.PushBack(2), l.PushBack(3), l.PushBack(4)}
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 7, -1, -3, -6} {
+		l.Rotate(n)
+		k := ((n % len(es)) + len(es)) % len(es)
+		want := append(append([]*Element[int]{}, es[k:]...), es[:k]...)
+		checkListPointers(t, l, want)
+		es = want
+	}
+
+	var z List[int]
+	z.Rotate(3)
+	checkListPointers(t, &z, []*Element[int]{})
+}
+
+func TestShuffle(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	l := New[int]()
+	for i := 0; i < 50; i++ {
+		l.PushBack(i)
+	}
+	l.Shuffle(r)
+
+	var es []*Element[int]
+	seen := make(map[int]bool)
+	moved := false
+	for i, e := range l.Enumerate() {
+		seen[e] = true
+		moved = moved || e != i
+	}
+	for e := range l.Elements() {
+		es = append(es, e)
+	}
+	checkListPointers(t, l, es)
+	if len(seen) != 50 {
+		t.Errorf("shuffled list has %d distinct values, want 50", len(seen))
+	}
+	if !moved {
+		t.Errorf("Shuffle left the list in its original order")
+	}
+
+	var z List[int]
+	z.Shuffle(r)
+	checkListPointers(t, &z, []*Element[int]{})
+}
@@ -0,0 +1,66 @@
+package list
+
+// ToSlice returns a new slice containing the values of list l from front to back.
+func (l *List[E]) ToSlice() []E {
+	return l.AppendTo(make([]E, 0, l.Len()))
+}
+
+// AppendTo appends the values of list l from front to back to dst and
+// returns the extended slice.
+func (l *List[E]) AppendTo(dst []E) []E {
+	for e := l.Front(); e != nil; e = e.Next() {
+		dst = append(dst, e.Value)
+	}
+	return dst
+}
+
+// NewFromSlice returns a new list containing the values of vals, in order.
+func NewFromSlice[E any](vals []E) *List[E] {
+	l := New[E]()
+	l.PushBackSlice(vals)
+	return l
+}
+
+// PushBackSlice inserts the values of vals at the back of list l, in order.
+func (l *List[E]) PushBackSlice(vals []E) {
+	l.lazyInit()
+	l.insertSlice(vals, l.root.prev)
+}
+
+// PushFrontSlice inserts the values of vals at the front of list l, in order,
+// so that vals[0] becomes the front of l.
+func (l *List[E]) PushFrontSlice(vals []E) {
+	l.lazyInit()
+	l.insertSlice(vals, &l.root)
+}
+
+// PushBackMany inserts vals at the back of list l, in order. Like
+// PushBackSlice it takes elements from the pool first and allocates the
+// rest as a single block.
+func (l *List[E]) PushBackMany(vals ...E) { l.PushBackSlice(vals) }
+
+// PushFrontMany inserts vals at the front of list l, in order, so that
+// vals[0] becomes the front of l. Like PushFrontSlice it takes elements
+// from the pool first and allocates the rest as a single block.
+func (l *List[E]) PushFrontMany(vals ...E) { l.PushFrontSlice(vals) }
+
+// insertSlice inserts the values of vals in order after at. Pooled elements
+// are used first and the remainder is allocated as a single block, unless l
+// uses a shared allocator.
+func (l *List[E]) insertSlice(vals []E, at *Element[E]) {
+	for len(vals) > 0 && (len(l.epool) > 0 || l.alloc != nil) {
+		at = l.insertValue(vals[0], at)
+		vals = vals[1:]
+	}
+	if len(vals) == 0 {
+		return
+	}
+	block := make([]Element[E], len(vals))
+	l.counters.allocs += uint64(len(vals))
+	l.counters.misses += uint64(len(vals))
+	for i := range block {
+		e := &block[i]
+		e.Value = vals[i]
+		at = l.insert(e, at)
+	}
+}
@@ -0,0 +1,78 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestToSlice(t *testing.T) {
+	var l List[int]
+	if s := l.ToSlice(); s == nil || len(s) != 0 {
+		t.Errorf("empty l.ToSlice() = %#v, want empty non-nil slice", s)
+	}
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushBack(3)
+	if got, want := l.ToSlice(), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("l.ToSlice() = %v, want %v", got, want)
+	}
+}
+
+func TestAppendTo(t *testing.T) {
+	l := New[int]()
+	l.PushBack(2)
+	l.PushBack(3)
+	buf := make([]int, 1, 8)
+	buf[0] = 1
+	got := l.AppendTo(buf)
+	if want := []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("l.AppendTo = %v, want %v", got, want)
+	}
+	if &got[0] != &buf[0] {
+		t.Errorf("l.AppendTo reallocated despite sufficient capacity")
+	}
+}
+
+func TestNewFromSlice(t *testing.T) {
+	l := NewFromSlice([]int{1, 2, 3})
+	if got, want := l.ToSlice(), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("NewFromSlice = %v, want %v", got, want)
+	}
+	l = NewFromSlice[int](nil)
+	checkListPointers(t, l, []*Element[int]{})
+}
+
+func TestPushSlice(t *testing.T) {
+	var l List[int]
+	l.PushBackSlice([]int{3, 4})
+	l.PushFrontSlice([]int{1, 2})
+	if got, want := l.ToSlice(), []int{1, 2, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("l = %v, want %v", got, want)
+	}
+
+	// Exercise the pool: removed elements are reused before a new block is allocated.
+	l.Remove(l.Front())
+	l.Remove(l.Front())
+	l.PushBackSlice([]int{5, 6, 7})
+	if got, want := l.ToSlice(), []int{3, 4, 5, 6, 7}; !slices.Equal(got, want) {
+		t.Errorf("l = %v, want %v", got, want)
+	}
+	es := []*Element[int]{}
+	for e := range l.Elements() {
+		es = append(es, e)
+	}
+	checkListPointers(t, &l, es)
+}
+
+func TestPushMany(t *testing.T) {
+	var l List[int]
+	l.PushBackMany(3, 4)
+	l.PushFrontMany(1, 2)
+	l.PushBackMany()
+	if got, want := l.ToSlice(), []int{1, 2, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("l = %v, want %v", got, want)
+	}
+	if n := testing.AllocsPerRun(10, func() { l.PushBackMany(5, 6, 7, 8) }); n != 1 {
+		t.Errorf("PushBackMany allocated %v times, want 1", n)
+	}
+}
@@ -0,0 +1,97 @@
+package list
+
+import "iter"
+
+// SElement is an element of a singly linked list.
+type SElement[E any] struct {
+	next *SElement[E]
+
+	// The value stored with this element.
+	Value E
+}
+
+// Next returns the next list element or nil.
+func (e *SElement[E]) Next() *SElement[E] { return e.next }
+
+// SList is a singly linked list. Each element has one link pointer
+// instead of two, which suits stacks and free lists that only ever walk
+// forwards. Elements do not record the list they belong to, so the
+// caller must only pass marks taken from the same list; building with the
+// listdebug tag checks this. Elements can be inserted and removed only
+// after a known element or at the front.
+// The zero value for SList is an empty list ready to use.
+type SList[E any] struct {
+	head *SElement[E]
+	len  int
+}
+
+// NewSList returns an empty singly linked list.
+func NewSList[E any]() *SList[E] { return new(SList[E]) }
+
+// Len returns the number of elements of list l.
+func (l *SList[E]) Len() int { return l.len }
+
+// Front returns the first element of list l or nil if the list is empty.
+func (l *SList[E]) Front() *SElement[E] { return l.head }
+
+// PushFront inserts a new element with value v at the front of list l and
+// returns it.
+func (l *SList[E]) PushFront(v E) *SElement[E] {
+	l.head = &SElement[E]{next: l.head, Value: v}
+	l.len++
+	return l.head
+}
+
+// PopFront removes the first element of list l and returns its value, or
+// the zero value and false if l is empty.
+func (l *SList[E]) PopFront() (E, bool) {
+	e := l.head
+	if e == nil {
+		var zero E
+		return zero, false
+	}
+	l.head = e.next
+	l.len--
+	e.next = nil
+	return e.Value, true
+}
+
+// InsertAfter inserts a new element with value v immediately after mark
+// and returns it. The mark must be an element of l.
+func (l *SList[E]) InsertAfter(v E, mark *SElement[E]) *SElement[E] {
+	if debug {
+		l.debugOwns(mark)
+	}
+	mark.next = &SElement[E]{next: mark.next, Value: v}
+	l.len++
+	return mark.next
+}
+
+// RemoveAfter removes the element after mark and returns its value, or
+// the zero value and false if mark is the last element. The mark must be
+// an element of l.
+func (l *SList[E]) RemoveAfter(mark *SElement[E]) (E, bool) {
+	if debug {
+		l.debugOwns(mark)
+	}
+	e := mark.next
+	if e == nil {
+		var zero E
+		return zero, false
+	}
+	mark.next = e.next
+	l.len--
+	e.next = nil
+	return e.Value, true
+}
+
+// All returns an iterator over the values of l from front to back.
+func (l *SList[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for e := l.head; e != nil; e = e.next {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
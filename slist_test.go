@@ -0,0 +1,39 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSList(t *testing.T) {
+	var l SList[int]
+	if _, ok := l.PopFront(); ok || l.Front() != nil {
+		t.Errorf("empty SList not empty")
+	}
+	e3 := l.PushFront(3)
+	e1 := l.PushFront(1)
+	l.InsertAfter(2, e1)
+	l.InsertAfter(4, e3)
+	if got, want := slices.Collect(l.All()), []int{1, 2, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("l.All() = %v, want %v", got, want)
+	}
+	if l.Len() != 4 || l.Front() != e1 || e1.Next().Next() != e3 {
+		t.Errorf("Len, Front or Next wrong")
+	}
+
+	if v, ok := l.RemoveAfter(e1); !ok || v != 2 {
+		t.Errorf("l.RemoveAfter(e1) = %d, %v; want 2, true", v, ok)
+	}
+	if _, ok := l.RemoveAfter(e3.Next()); ok {
+		t.Errorf("RemoveAfter last element ok")
+	}
+
+	for _, want := range []int{1, 3, 4} {
+		if v, ok := l.PopFront(); !ok || v != want {
+			t.Errorf("l.PopFront() = %d, %v; want %d, true", v, ok, want)
+		}
+	}
+	if l.Len() != 0 || l.Front() != nil {
+		t.Errorf("l not empty after popping every value")
+	}
+}
@@ -0,0 +1,35 @@
+package list
+
+// Snapshot returns a new list holding a copy of the values of list l.
+// The copy shares no elements with l, so it can be read freely while l
+// continues to be modified, provided the snapshot itself is taken while
+// writers are excluded. Its elements are allocated as a single block.
+//
+// There is no copy-on-write alternative that shares elements between
+// lists: callers hold element pointers and assign to Value directly, so a
+// write to a shared element cannot be detected. Snapshot is the cheapest
+// way to copy a list.
+func (l *List[E]) Snapshot() *List[E] {
+	r := New[E]()
+	block := make([]Element[E], l.Len())
+	r.counters.allocs = uint64(len(block))
+	r.counters.misses = uint64(len(block))
+	at := &r.root
+	i := 0
+	for e := l.Front(); e != nil; e = e.Next() {
+		x := &block[i]
+		x.Value = e.Value
+		at = r.insert(x, at)
+		i++
+	}
+	return r
+}
+
+// Snapshot returns a copy of the values of s, taken under the read lock.
+// The returned list is owned by the caller and unaffected by later
+// modifications of s.
+func (s *SyncList[E]) Snapshot() *List[E] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.Snapshot()
+}
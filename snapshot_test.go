@@ -0,0 +1,45 @@
+package list
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSnapshot(t *testing.T) {
+	l := NewFromSlice([]int{1, 2, 3})
+	s := l.Snapshot()
+	l.PushBack(4)
+	l.Front().Value = 10
+	if !Equal(s, NewFromSlice([]int{1, 2, 3})) {
+		t.Errorf("snapshot = %v, want [1 2 3]", s.ToSlice())
+	}
+	if err := s.CheckInvariants(); err != nil {
+		t.Error(err)
+	}
+	if z := new(List[int]).Snapshot(); z.Len() != 0 {
+		t.Errorf("snapshot of empty list has length %d", z.Len())
+	}
+}
+
+func TestSyncListSnapshot(t *testing.T) {
+	var s SyncList[int]
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			s.PushBack(i)
+		}
+	}()
+	for i := 0; i < 50; i++ {
+		snap := s.Snapshot()
+		prev := -1
+		for v := range snap.All() {
+			if v != prev+1 {
+				t.Fatalf("snapshot not a prefix: %d follows %d", v, prev)
+			}
+			prev = v
+		}
+	}
+	wg.Wait()
+}
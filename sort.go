@@ -0,0 +1,277 @@
+package list
+
+import (
+	"cmp"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// SortFunc sorts list l in ascending order as determined by the cmp function.
+// The sort is stable and works by relinking the existing elements, so element
+// pointers held by the caller remain valid. It runs in O(n log n) time and
+// uses O(1) extra space.
+//
+// cmp(a, b) should return a negative number when a < b, a positive number when
+// a > b and zero when a == b.
+func (l *List[E]) SortFunc(cmp func(a, b E) int) {
+	if l.len < 2 {
+		return
+	}
+	if l.hooks != nil {
+		l.hooks.reordering(l)
+	}
+	// Sort the elements as a nil terminated chain linked through next,
+	// restoring the prev links and the ring once the chain is ordered.
+	head := l.root.next
+	l.root.prev.next = nil
+	l.relinkChain(sortChain(head, l.len, cmp))
+}
+
+// SortFuncParallel is like SortFunc but splits l into up to maxProcs runs
+// that are sorted and merged on separate goroutines. If maxProcs <= 0,
+// runtime.GOMAXPROCS(0) is used. Small lists are sorted on the calling
+// goroutine. The cmp function must be safe for concurrent use.
+func (l *List[E]) SortFuncParallel(cmp func(a, b E) int, maxProcs int) {
+	// minParallelRun is the smallest run worth handing to its own goroutine.
+	const minParallelRun = 1 << 12
+
+	if maxProcs <= 0 {
+		maxProcs = runtime.GOMAXPROCS(0)
+	}
+	if n := l.len / minParallelRun; n < maxProcs {
+		maxProcs = n
+	}
+	if maxProcs < 2 {
+		l.SortFunc(cmp)
+		return
+	}
+	if l.hooks != nil {
+		l.hooks.reordering(l)
+	}
+
+	head := l.root.next
+	l.root.prev.next = nil
+	runs := make([]*Element[E], maxProcs)
+	size := l.len / maxProcs
+	var wg sync.WaitGroup
+	for i := range runs {
+		n := size
+		if i == len(runs)-1 {
+			n = l.len - size*(len(runs)-1)
+		}
+		run := head
+		head = cutChain(head, n)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			runs[i] = sortChain(run, n, cmp)
+		}()
+	}
+	wg.Wait()
+
+	// Merge neighbouring runs pairwise so that ties keep their original order.
+	for len(runs) > 1 {
+		merged := make([]*Element[E], (len(runs)+1)/2)
+		for i := 0; i+1 < len(runs); i += 2 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				merged[i/2], _ = mergeChains(runs[i], runs[i+1], cmp)
+			}()
+		}
+		if len(runs)%2 == 1 {
+			merged[len(merged)-1] = runs[len(runs)-1]
+		}
+		wg.Wait()
+		runs = merged
+	}
+	l.relinkChain(runs[0])
+}
+
+// Sort sorts list l in ascending order.
+// When sorting floating-point numbers, NaNs are ordered before other values.
+func Sort[E cmp.Ordered](l *List[E]) {
+	l.SortFunc(cmp.Compare[E])
+}
+
+// SortAdapter returns a sort.Interface over list l ordered by less, so l can
+// be used with the sort package and other algorithms written against
+// sort.Interface. The adapter indexes the elements of l when it is created;
+// its Swap relinks the elements themselves, so element pointers follow their
+// values. List l must not be structurally modified while the adapter is in
+// use except through it.
+func SortAdapter[E any](l *List[E], less func(a, b E) bool) sort.Interface {
+	es := make([]*Element[E], 0, l.Len())
+	for e := l.Front(); e != nil; e = e.Next() {
+		es = append(es, e)
+	}
+	return &sortAdapter[E]{l: l, es: es, less: less}
+}
+
+type sortAdapter[E any] struct {
+	l    *List[E]
+	es   []*Element[E]
+	less func(a, b E) bool
+}
+
+func (s *sortAdapter[E]) Len() int           { return len(s.es) }
+func (s *sortAdapter[E]) Less(i, j int) bool { return s.less(s.es[i].Value, s.es[j].Value) }
+
+func (s *sortAdapter[E]) Swap(i, j int) {
+	s.l.Swap(s.es[i], s.es[j])
+	s.es[i], s.es[j] = s.es[j], s.es[i]
+}
+
+// InsertSortedFunc inserts a new element with value v into the sorted list l
+// at its ordered position and returns it. The new element is placed after any
+// existing elements that compare equal to v. The search starts at the back of
+// l, so appending values in near sorted order is cheap.
+func (l *List[E]) InsertSortedFunc(v E, cmp func(a, b E) int) *Element[E] {
+	l.lazyInit()
+	at := l.root.prev
+	for at != &l.root && cmp(at.Value, v) > 0 {
+		at = at.prev
+	}
+	return l.insertValue(v, at)
+}
+
+// MergeFunc merges the sorted list other into the sorted list l, leaving
+// other empty. Elements are relinked rather than copied, so pointers to them
+// remain valid. The merge is stable: on ties, elements of l precede elements
+// of other. If other is l, the list is not modified.
+func (l *List[E]) MergeFunc(other *List[E], cmp func(a, b E) int) {
+	if other == l || other.len == 0 {
+		return
+	}
+	l.lazyInit()
+	if other.hooks != nil {
+		other.hooks.removing(other, other.root.next, other.root.prev)
+	}
+	if l.hooks != nil {
+		l.hooks.reordering(l)
+	}
+	var merged []*Element[E] // other's elements, to report to l's hooks
+	for e := other.root.next; e != &other.root; e = e.next {
+		e.list = l
+		if l.hooks != nil {
+			merged = append(merged, e)
+		}
+	}
+	b := other.root.next
+	other.root.prev.next = nil
+	other.root.next = &other.root
+	other.root.prev = &other.root
+	n := other.len
+	other.len = 0
+	other.mod++
+	other.countRemoves(n)
+	l.countInserts(n)
+	l.handles = l.handles || other.handles
+
+	a := l.root.next
+	l.root.prev.next = nil
+	if l.len == 0 {
+		a = nil
+	}
+	head, _ := mergeChains(a, b, cmp)
+	l.relinkChain(head)
+	l.len += n
+	l.grew()
+	if l.hooks != nil {
+		for _, e := range merged {
+			notify(l.hooks.onInsert, e, e)
+		}
+	}
+}
+
+// MergeSortedFunc returns a new list holding the elements of the sorted
+// lists a and b merged in sorted order, leaving a and b empty. Elements are
+// relinked rather than copied. The merge is stable: on ties, elements of a
+// precede elements of b.
+func MergeSortedFunc[E any](a, b *List[E], cmp func(a, b E) int) *List[E] {
+	r := New[E]()
+	r.TakeAll(a)
+	r.MergeFunc(b, cmp)
+	return r
+}
+
+// sortChain stably sorts the nil terminated chain of n elements starting at
+// head using a bottom-up merge sort and returns the new head.
+func sortChain[E any](head *Element[E], n int, cmp func(a, b E) int) *Element[E] {
+	for k := 1; k < n; k *= 2 {
+		var tail *Element[E]
+		rest := head
+		head = nil
+		for rest != nil {
+			a := rest
+			b := cutChain(a, k)
+			rest = cutChain(b, k)
+			h, t := mergeChains(a, b, cmp)
+			if tail == nil {
+				head = h
+			} else {
+				tail.next = h
+			}
+			tail = t
+		}
+	}
+	return head
+}
+
+// cutChain detaches the first n elements of the chain starting at e and
+// returns the head of the remainder.
+func cutChain[E any](e *Element[E], n int) *Element[E] {
+	if e == nil {
+		return nil
+	}
+	for ; n > 1 && e.next != nil; n-- {
+		e = e.next
+	}
+	rest := e.next
+	e.next = nil
+	return rest
+}
+
+// mergeChains stably merges the sorted chains a and b and returns the head
+// and tail of the result. On ties, elements of a come first.
+func mergeChains[E any](a, b *Element[E], cmp func(a, b E) int) (head, tail *Element[E]) {
+	link := &head
+	for a != nil && b != nil {
+		if cmp(b.Value, a.Value) < 0 {
+			tail = b
+			b = b.next
+		} else {
+			tail = a
+			a = a.next
+		}
+		*link = tail
+		link = &tail.next
+	}
+	if a == nil {
+		a = b
+	}
+	*link = a
+	for ; a != nil; a = a.next {
+		tail = a
+	}
+	return head, tail
+}
+
+// relinkChain makes the nil terminated chain starting at head, linked
+// through next, the contents of l, restoring prev pointers and the ring.
+// The chain must contain exactly the elements of l.
+func (l *List[E]) relinkChain(head *Element[E]) {
+	prev := &l.root
+	for e := head; e != nil; e = e.next {
+		e.prev = prev
+		prev.next = e
+		prev = e
+	}
+	prev.next = &l.root
+	l.root.prev = prev
+	l.mod++
+	if l.hooks != nil {
+		l.hooks.reordered(l)
+	}
+}
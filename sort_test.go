@@ -0,0 +1,119 @@
+package list
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"sort"
+	"testing"
+)
+
+type sortItem struct {
+	key, seq int
+}
+
+func TestSortFunc(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 7, 8, 9, 100, 1023} {
+		l := New[sortItem]()
+		var es []*Element[sortItem]
+		for i := 0; i < n; i++ {
+			es = append(es, l.PushBack(sortItem{key: r.Intn(10), seq: i}))
+		}
+		byKey := func(a, b sortItem) int { return cmp.Compare(a.key, b.key) }
+		l.SortFunc(byKey)
+
+		want := slices.Clone(es)
+		slices.SortStableFunc(want, func(a, b *Element[sortItem]) int { return byKey(a.Value, b.Value) })
+		checkListPointers(t, l, want)
+	}
+}
+
+func TestSort(t *testing.T) {
+	l := NewFromSlice([]string{"pear", "apple", "fig", "banana"})
+	Sort(l)
+	if got, want := l.ToSlice(), []string{"apple", "banana", "fig", "pear"}; !slices.Equal(got, want) {
+		t.Errorf("Sort = %v, want %v", got, want)
+	}
+}
+
+func TestSortFuncParallel(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	byKey := func(a, b sortItem) int { return cmp.Compare(a.key, b.key) }
+	for _, n := range []int{0, 5, 1 << 12, 50001} {
+		for _, procs := range []int{0, 1, 3, 8} {
+			l := New[sortItem]()
+			var es []*Element[sortItem]
+			for i := 0; i < n; i++ {
+				es = append(es, l.PushBack(sortItem{key: r.Intn(100), seq: i}))
+			}
+			l.SortFuncParallel(byKey, procs)
+			slices.SortStableFunc(es, func(a, b *Element[sortItem]) int { return byKey(a.Value, b.Value) })
+			checkListPointers(t, l, es)
+		}
+	}
+}
+
+func TestMergeFunc(t *testing.T) {
+	byKey := func(a, b sortItem) int { return cmp.Compare(a.key, b.key) }
+	a := New[sortItem]()
+	b := New[sortItem]()
+	a1 := a.PushBack(sortItem{1, 0})
+	a2 := a.PushBack(sortItem{3, 1})
+	a3 := a.PushBack(sortItem{3, 2})
+	b1 := b.PushBack(sortItem{0, 3})
+	b2 := b.PushBack(sortItem{3, 4})
+	b3 := b.PushBack(sortItem{5, 5})
+
+	a.MergeFunc(b, byKey)
+	checkListPointers(t, a, []*Element[sortItem]{b1, a1, a2, a3, b2, b3})
+	checkListPointers(t, b, []*Element[sortItem]{})
+	for e := range a.Elements() {
+		if e.list != a {
+			t.Errorf("element %v not owned by merged list", e.Value)
+		}
+	}
+
+	// Merging into an empty list.
+	var z List[sortItem]
+	z.MergeFunc(a, byKey)
+	checkListPointers(t, &z, []*Element[sortItem]{b1, a1, a2, a3, b2, b3})
+}
+
+func TestMergeSortedFunc(t *testing.T) {
+	a := NewFromSlice([]int{1, 4, 6})
+	b := NewFromSlice([]int{2, 3, 7, 8})
+	r := MergeSortedFunc(a, b, cmp.Compare[int])
+	if got, want := r.ToSlice(), []int{1, 2, 3, 4, 6, 7, 8}; !slices.Equal(got, want) {
+		t.Errorf("MergeSortedFunc = %v, want %v", got, want)
+	}
+	checkListLen(t, a, 0)
+	checkListLen(t, b, 0)
+}
+
+func TestInsertSortedFunc(t *testing.T) {
+	byKey := func(a, b sortItem) int { return cmp.Compare(a.key, b.key) }
+	var l List[sortItem]
+	e1 := l.InsertSortedFunc(sortItem{2, 0}, byKey)
+	e2 := l.InsertSortedFunc(sortItem{1, 1}, byKey)
+	e3 := l.InsertSortedFunc(sortItem{3, 2}, byKey)
+	e4 := l.InsertSortedFunc(sortItem{2, 3}, byKey)
+	e5 := l.InsertSortedFunc(sortItem{0, 4}, byKey)
+	checkListPointers(t, &l, []*Element[sortItem]{e5, e2, e1, e4, e3})
+}
+
+func TestSortAdapter(t *testing.T) {
+	l := New[sortItem]()
+	var es []*Element[sortItem]
+	r := rand.New(rand.NewSource(2))
+	for i := 0; i < 200; i++ {
+		es = append(es, l.PushBack(sortItem{key: r.Intn(20), seq: i}))
+	}
+	less := func(a, b sortItem) bool { return a.key < b.key }
+	sort.Stable(SortAdapter(l, less))
+	slices.SortStableFunc(es, func(a, b *Element[sortItem]) int { return cmp.Compare(a.Value.key, b.Value.key) })
+	checkListPointers(t, l, es)
+	if !sort.IsSorted(SortAdapter(l, less)) {
+		t.Errorf("sort.IsSorted = false after sort.Stable")
+	}
+}
@@ -0,0 +1,79 @@
+package list
+
+import "iter"
+
+// SortedList is a list that keeps its values in ascending order as
+// determined by a comparison function. Values that compare equal are kept
+// in insertion order.
+//
+// Elements returned by a SortedList belong to an internal List. Callers may
+// traverse them with Next and Prev but must not modify their Value or move
+// them, as that would break the ordering.
+type SortedList[E any] struct {
+	list List[E]
+	cmp  func(a, b E) int
+}
+
+// NewSortedList returns an empty sorted list ordered by cmp.
+// cmp(a, b) should return a negative number when a < b, a positive number
+// when a > b and zero when a == b.
+func NewSortedList[E any](cmp func(a, b E) int) *SortedList[E] {
+	s := &SortedList[E]{cmp: cmp}
+	s.list.Init()
+	return s
+}
+
+// Len returns the number of elements of sorted list s.
+func (s *SortedList[E]) Len() int { return s.list.Len() }
+
+// Front returns the smallest element of s or nil if s is empty.
+func (s *SortedList[E]) Front() *Element[E] { return s.list.Front() }
+
+// Back returns the largest element of s or nil if s is empty.
+func (s *SortedList[E]) Back() *Element[E] { return s.list.Back() }
+
+// Insert inserts a new element with value v at its ordered position in s
+// and returns it.
+func (s *SortedList[E]) Insert(v E) *Element[E] {
+	return s.list.InsertSortedFunc(v, s.cmp)
+}
+
+// Remove removes e from s if e is an element of s and returns e.Value.
+// The element must not be nil.
+func (s *SortedList[E]) Remove(e *Element[E]) E {
+	return s.list.Remove(e)
+}
+
+// Find returns the first element of s whose value compares equal to v,
+// or nil if there is none.
+func (s *SortedList[E]) Find(v E) *Element[E] {
+	if e := s.Ceiling(v); e != nil && s.cmp(e.Value, v) == 0 {
+		return e
+	}
+	return nil
+}
+
+// Ceiling returns the first element of s whose value is greater than or
+// equal to v, or nil if there is none.
+func (s *SortedList[E]) Ceiling(v E) *Element[E] {
+	for e := s.list.Front(); e != nil; e = e.Next() {
+		if s.cmp(e.Value, v) >= 0 {
+			return e
+		}
+	}
+	return nil
+}
+
+// Floor returns the last element of s whose value is less than or equal to
+// v, or nil if there is none.
+func (s *SortedList[E]) Floor(v E) *Element[E] {
+	for e := s.list.Back(); e != nil; e = e.Prev() {
+		if s.cmp(e.Value, v) <= 0 {
+			return e
+		}
+	}
+	return nil
+}
+
+// All returns an iterator over the values of s in ascending order.
+func (s *SortedList[E]) All() iter.Seq[E] { return s.list.All() }
@@ -0,0 +1,53 @@
+package list
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestSortedList(t *testing.T) {
+	s := NewSortedList(cmp.Compare[int])
+	for _, v := range []int{5, 1, 4, 1, 9, 2} {
+		s.Insert(v)
+	}
+	if got, want := slices.Collect(s.All()), []int{1, 1, 2, 4, 5, 9}; !slices.Equal(got, want) {
+		t.Errorf("s.All() = %v, want %v", got, want)
+	}
+	if s.Len() != 6 || s.Front().Value != 1 || s.Back().Value != 9 {
+		t.Errorf("Len, Front, Back = %d, %d, %d; want 6, 1, 9", s.Len(), s.Front().Value, s.Back().Value)
+	}
+
+	if e := s.Find(1); e != s.Front() {
+		t.Errorf("s.Find(1) is not the first 1")
+	}
+	if e := s.Find(3); e != nil {
+		t.Errorf("s.Find(3) = %v, want nil", e.Value)
+	}
+
+	tests := []struct {
+		v            int
+		ceil, floor  int
+		noCeil, noFl bool
+	}{
+		{v: 0, ceil: 1, noFl: true},
+		{v: 3, ceil: 4, floor: 2},
+		{v: 4, ceil: 4, floor: 4},
+		{v: 10, noCeil: true, floor: 9},
+	}
+	for _, tt := range tests {
+		if e := s.Ceiling(tt.v); (e == nil) != tt.noCeil || e != nil && e.Value != tt.ceil {
+			t.Errorf("s.Ceiling(%d) = %v, want %d (none: %v)", tt.v, e, tt.ceil, tt.noCeil)
+		}
+		if e := s.Floor(tt.v); (e == nil) != tt.noFl || e != nil && e.Value != tt.floor {
+			t.Errorf("s.Floor(%d) = %v, want %d (none: %v)", tt.v, e, tt.floor, tt.noFl)
+		}
+	}
+
+	if v := s.Remove(s.Find(4)); v != 4 {
+		t.Errorf("s.Remove = %d, want 4", v)
+	}
+	if got, want := slices.Collect(s.All()), []int{1, 1, 2, 5, 9}; !slices.Equal(got, want) {
+		t.Errorf("after Remove s.All() = %v, want %v", got, want)
+	}
+}
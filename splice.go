@@ -0,0 +1,223 @@
+package list
+
+// SpliceBefore moves the elements from first to last inclusive out of list
+// from and inserts them, in order, immediately before mark in list l.
+// The lists l and from may be the same. The elements themselves are relinked,
+// not copied, so pointers to them remain valid.
+//
+// If mark is not an element of l, first or last is not an element of from,
+// last does not follow first, or mark lies within the range, the lists are
+// not modified. If mark directly follows last, the range is already in
+// place and nothing moves. The links are updated in O(1); counting the
+// range and updating its ownership takes time proportional to its length.
+// The elements must not be nil.
+func (l *List[E]) SpliceBefore(mark, first, last *Element[E], from *List[E]) {
+	if !l.isElem("SpliceBefore", mark, l) ||
+		!l.isElem("SpliceBefore", first, from) ||
+		!l.isElem("SpliceBefore", last, from) {
+		return
+	}
+	n := 1
+	for e := first; e != last; e = e.next {
+		if e == &from.root {
+			l.misuse("SpliceBefore", "last does not follow first")
+			return
+		}
+		if e == mark {
+			l.misuse("SpliceBefore", "mark lies within the range")
+			return
+		}
+		n++
+	}
+	if last == mark {
+		l.misuse("SpliceBefore", "mark lies within the range")
+		return
+	}
+	if mark.prev == last {
+		return // the range is already in place
+	}
+	l.spliceRange(mark.prev, first, last, n, from)
+}
+
+// spliceRange unlinks the n elements from first to last inclusive from list
+// from and links them into l after at, which must not lie in the range.
+func (l *List[E]) spliceRange(at, first, last *Element[E], n int, from *List[E]) {
+	if debug {
+		assertf(first.list == from && last.list == from, "splice: range [%p, %p] does not belong to list %p", first, last, from)
+		assertf(at == &l.root || at.list == l, "splice: position %p belongs to list %p, not %p", at, at.list, l)
+	}
+	if from.hooks != nil {
+		if from == l {
+			l.hooks.moving(l, first)
+		} else {
+			from.hooks.removing(from, first, last)
+		}
+	}
+	first.prev.next = last.next
+	last.next.prev = first.prev
+	from.len -= n
+	from.mod++
+
+	// at may have been last.next, so read its neighbour only after unlinking.
+	first.prev = at
+	last.next = at.next
+	at.next.prev = last
+	at.next = first
+	l.len += n
+	l.mod++
+	l.grew()
+
+	if from == l {
+		l.countMoves(n)
+	} else {
+		from.countRemoves(n)
+		l.countInserts(n)
+		l.handles = l.handles || from.handles
+		for e := first; ; e = e.next {
+			e.list = l
+			if e == last {
+				break
+			}
+		}
+	}
+	if l.hooks != nil {
+		if from == l {
+			l.hooks.moved(l, first, last)
+		} else {
+			l.hooks.inserted(l, first, last)
+		}
+	}
+}
+
+// TakeAll moves all elements of list other to the back of list l, leaving
+// other empty. The elements are relinked rather than copied, so pointers to
+// them remain valid and now refer to elements of l. Relinking is O(1), but
+// each element's owner must be updated, which takes time proportional to
+// other.Len(). If other is l, the list is not modified.
+func (l *List[E]) TakeAll(other *List[E]) {
+	if other == l || other.len == 0 {
+		return
+	}
+	l.lazyInit()
+	l.spliceRange(l.root.prev, other.root.next, other.root.prev, other.len, other)
+}
+
+// SplitBefore detaches the elements of list l from e through the back of l
+// and returns them as a new list, leaving the elements before e in l.
+// The elements are relinked rather than copied, so pointers to them remain
+// valid. It takes time proportional to the number of elements detached.
+// If e is not an element of l, SplitBefore returns nil and l is not modified.
+// The element must not be nil.
+func (l *List[E]) SplitBefore(e *Element[E]) *List[E] {
+	if !l.isElem("SplitBefore", e, l) {
+		return nil
+	}
+	n := 0
+	for x := e; x != &l.root; x = x.next {
+		n++
+	}
+	r := New[E]()
+	r.spliceRange(&r.root, e, l.root.prev, n, l)
+	return r
+}
+
+// RemoveRange removes the elements of list l from first to last inclusive
+// and returns the number of elements removed. Removed elements are recycled,
+// so pointers to them must not be used afterwards. If first or last is not an
+// element of l, or last does not follow first, the list is not modified and
+// RemoveRange returns 0. The elements must not be nil.
+func (l *List[E]) RemoveRange(first, last *Element[E]) int {
+	if !l.isElem("RemoveRange", first, l) || !l.isElem("RemoveRange", last, l) {
+		return 0
+	}
+	n := 1
+	for e := first; e != last; e = e.next {
+		if e == &l.root {
+			l.misuse("RemoveRange", "last does not follow first")
+			return 0
+		}
+		n++
+	}
+	for e, i := first, 0; i < n; i++ {
+		next := e.next
+		l.remove(e)
+		e = next
+	}
+	return n
+}
+
+// MoveRangeBefore moves the elements of list l from first to last inclusive
+// to their new position immediately before mark, keeping their order.
+// It is equivalent to l.SpliceBefore(mark, first, last, l): the move itself
+// relinks O(1) pointers, but checking the range walks it once.
+// If any element is not an element of l, last does not follow first, mark
+// lies within the range, or mark directly follows last, the list is not
+// modified.
+// The elements must not be nil.
+func (l *List[E]) MoveRangeBefore(first, last, mark *Element[E]) {
+	l.SpliceBefore(mark, first, last, l)
+}
+
+// Truncate removes all but the first n elements of list l. Removed elements
+// are recycled. If n >= l.Len(), the list is not modified.
+// Truncate panics if n is negative.
+func (l *List[E]) Truncate(n int) {
+	if n < 0 {
+		panic("list: negative Truncate length")
+	}
+	for l.len > n {
+		l.remove(l.root.prev)
+	}
+}
+
+// Partition moves the elements of list l into two new lists: matched holds
+// the elements whose values satisfy pred and rest holds the others, each in
+// their original relative order. List l is left empty. The elements are
+// relinked rather than copied, so pointers to them remain valid.
+func (l *List[E]) Partition(pred func(E) bool) (matched, rest *List[E]) {
+	matched, rest = New[E](), New[E]()
+	var next *Element[E]
+	for e := l.Front(); e != nil; e = next {
+		next = e.Next()
+		dst := rest
+		if pred(e.Value) {
+			dst = matched
+		}
+		dst.spliceRange(dst.root.prev, e, e, 1, l)
+	}
+	return matched, rest
+}
+
+// AdoptBack moves element e from the list it currently belongs to onto the
+// back of list l. The element is relinked rather than reallocated, so e
+// remains valid and now belongs to l. If e is not an element of any list,
+// l is not modified, or AdoptBack panics if l is in strict mode. The element
+// must not be nil.
+func (l *List[E]) AdoptBack(e *Element[E]) {
+	if e.list == nil {
+		l.misuse("AdoptBack", "element %p is not an element of any list", e)
+		return
+	}
+	l.lazyInit()
+	if e == l.root.prev {
+		return
+	}
+	l.spliceRange(l.root.prev, e, e, 1, e.list)
+}
+
+// AdoptFront moves element e from the list it currently belongs to onto the
+// front of list l. The element is relinked rather than reallocated, so e
+// remains valid and now belongs to l. If e is not an element of any list,
+// l is not modified, or AdoptFront panics if l is in strict mode. The element
+// must not be nil.
+func (l *List[E]) AdoptFront(e *Element[E]) {
+	if e.list == nil {
+		l.misuse("AdoptFront", "element %p is not an element of any list", e)
+		return
+	}
+	l.lazyInit()
+	if e == l.root.next {
+		return
+	}
+	l.spliceRange(&l.root, e, e, 1, e.list)
+}
@@ -0,0 +1,216 @@
+package list
+
+import "testing"
+
+func TestSpliceBefore(t *testing.T) {
+	a := New[int]()
+	a1 := a.PushBack(1)
+	a2 := a.PushBack(2)
+	a3 := a.PushBack(3)
+	a4 := a.PushBack(4)
+	b := New[int]()
+	b1 := b.PushBack(10)
+	b2 := b.PushBack(20)
+
+	// Move a range between lists.
+	b.SpliceBefore(b2, a2, a3, a)
+	checkListPointers(t, a, []*Element[int]{a1, a4})
+	checkListPointers(t, b, []*Element[int]{b1, a2, a3, b2})
+	if a2.list != b || a3.list != b {
+		t.Errorf("moved elements not owned by destination list")
+	}
+
+	// Move a range within the same list.
+	b.SpliceBefore(b1, a3, b2, b)
+	checkListPointers(t, b, []*Element[int]{a3, b2, b1, a2})
+
+	b.SpliceBefore(b1, a1, a4, a)
+	checkListPointers(t, a, []*Element[int]{})
+	checkListPointers(t, b, []*Element[int]{a3, b2, a1, a4, b1, a2})
+
+	// Invalid requests leave the lists alone.
+	b.SpliceBefore(a1, b2, b1, b) // mark inside the range
+	b.SpliceBefore(b2, a3, b2, b) // mark is last
+	b.SpliceBefore(a3, a2, a3, b) // last precedes first
+	b.SpliceBefore(a1, b2, b2, a) // range not in a
+	checkListPointers(t, b, []*Element[int]{a3, b2, a1, a4, b1, a2})
+
+	// A range directly before mark is already in place.
+	b.SpliceBefore(b1, a1, a4, b)
+	checkListPointers(t, b, []*Element[int]{a3, b2, a1, a4, b1, a2})
+	if err := b.CheckInvariants(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTakeAll(t *testing.T) {
+	var a, b List[int]
+	a.TakeAll(&b)
+	checkListPointers(t, &a, []*Element[int]{})
+
+	a1 := a.PushBack(1)
+	b1 := b.PushBack(2)
+	b2 := b.PushBack(3)
+	a.TakeAll(&b)
+	checkListPointers(t, &a, []*Element[int]{a1, b1, b2})
+	checkListPointers(t, &b, []*Element[int]{})
+	if b1.list != &a || b2.list != &a {
+		t.Errorf("taken elements not owned by l")
+	}
+
+	a.TakeAll(&a)
+	checkListPointers(t, &a, []*Element[int]{a1, b1, b2})
+
+	// A zero list can take from another list.
+	var c List[int]
+	c.TakeAll(&a)
+	checkListPointers(t, &c, []*Element[int]{a1, b1, b2})
+	b.PushBack(4)
+	checkListLen(t, &b, 1)
+}
+
+func TestSplitBefore(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+	e3 := l.PushBack(3)
+
+	r := l.SplitBefore(e2)
+	checkListPointers(t, l, []*Element[int]{e1})
+	checkListPointers(t, r, []*Element[int]{e2, e3})
+
+	r2 := r.SplitBefore(e2)
+	checkListPointers(t, r, []*Element[int]{})
+	checkListPointers(t, r2, []*Element[int]{e2, e3})
+
+	if got := l.SplitBefore(e3); got != nil {
+		t.Errorf("SplitBefore with foreign element = %v, want nil", got)
+	}
+	checkListPointers(t, l, []*Element[int]{e1})
+}
+
+func TestRemoveRange(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+	e3 := l.PushBack(3)
+	e4 := l.PushBack(4)
+	e5 := l.PushBack(5)
+
+	if n := l.RemoveRange(e4, e2); n != 0 {
+		t.Errorf("RemoveRange with reversed bounds = %d, want 0", n)
+	}
+	if n := New[int]().RemoveRange(e2, e4); n != 0 {
+		t.Errorf("RemoveRange on foreign list = %d, want 0", n)
+	}
+	checkListPointers(t, l, []*Element[int]{e1, e2, e3, e4, e5})
+
+	if n := l.RemoveRange(e2, e4); n != 3 {
+		t.Errorf("l.RemoveRange(e2, e4) = %d, want 3", n)
+	}
+	checkListPointers(t, l, []*Element[int]{e1, e5})
+	if n := l.RemoveRange(e5, e5); n != 1 {
+		t.Errorf("l.RemoveRange(e5, e5) = %d, want 1", n)
+	}
+	checkListPointers(t, l, []*Element[int]{e1})
+}
+
+func TestMoveRangeBefore(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+	e3 := l.PushBack(3)
+	e4 := l.PushBack(4)
+	e5 := l.PushBack(5)
+
+	l.MoveRangeBefore(e3, e4, e1)
+	checkListPointers(t, l, []*Element[int]{e3, e4, e1, e2, e5})
+	l.MoveRangeBefore(e3, e1, e5)
+	checkListPointers(t, l, []*Element[int]{e2, e3, e4, e1, e5})
+	l.MoveRangeBefore(e3, e1, e4) // mark inside range
+	checkListPointers(t, l, []*Element[int]{e2, e3, e4, e1, e5})
+
+	// Moving a range before its own successor leaves it in place.
+	l.MoveRangeBefore(l.At(1), l.At(2), l.At(3))
+	checkListPointers(t, l, []*Element[int]{e2, e3, e4, e1, e5})
+	if err := l.CheckInvariants(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+	l.PushBack(3)
+	l.PushBack(4)
+
+	l.Truncate(10)
+	checkListLen(t, l, 4)
+	l.Truncate(2)
+	checkListPointers(t, l, []*Element[int]{e1, e2})
+	l.Truncate(0)
+	checkListPointers(t, l, []*Element[int]{})
+
+	var z List[int]
+	z.Truncate(0)
+	checkListPointers(t, &z, []*Element[int]{})
+
+	mustPanic(t, "Truncate(-1)", func() { l.Truncate(-1) })
+}
+
+func TestPartition(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+	e3 := l.PushBack(3)
+	e4 := l.PushBack(4)
+	e5 := l.PushBack(5)
+
+	odd, even := l.Partition(func(v int) bool { return v%2 == 1 })
+	checkListPointers(t, odd, []*Element[int]{e1, e3, e5})
+	checkListPointers(t, even, []*Element[int]{e2, e4})
+	checkListPointers(t, l, []*Element[int]{})
+	if e2.list != even || e3.list != odd {
+		t.Errorf("partitioned elements have the wrong owner")
+	}
+
+	var z List[int]
+	m, r := z.Partition(func(int) bool { return true })
+	checkListLen(t, m, 0)
+	checkListLen(t, r, 0)
+}
+
+func TestAdopt(t *testing.T) {
+	a := New[int]()
+	a1 := a.PushBack(1)
+	a2 := a.PushBack(2)
+	var b List[int]
+
+	b.AdoptBack(a1)
+	checkListPointers(t, a, []*Element[int]{a2})
+	checkListPointers(t, &b, []*Element[int]{a1})
+	if a1.List() != &b {
+		t.Errorf("adopted element not owned by b")
+	}
+	b.AdoptFront(a2)
+	checkListPointers(t, a, []*Element[int]{})
+	checkListPointers(t, &b, []*Element[int]{a2, a1})
+
+	// Adopting from the same list moves the element.
+	b.AdoptFront(a1)
+	checkListPointers(t, &b, []*Element[int]{a1, a2})
+	b.AdoptBack(a1)
+	checkListPointers(t, &b, []*Element[int]{a2, a1})
+	b.AdoptBack(a1)
+	checkListPointers(t, &b, []*Element[int]{a2, a1})
+
+	free := &Element[int]{Value: 3}
+	b.AdoptBack(free)
+	b.AdoptFront(free)
+	checkListPointers(t, &b, []*Element[int]{a2, a1})
+	b.SetStrict(true)
+	mustPanic(t, "AdoptBack of free element", func() { b.AdoptBack(free) })
+	mustPanic(t, "AdoptFront of free element", func() { b.AdoptFront(free) })
+	checkListPointers(t, &b, []*Element[int]{a2, a1})
+}
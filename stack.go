@@ -0,0 +1,26 @@
+package list
+
+// Stack is a last-in, first-out stack backed by a List. It exposes only
+// stack operations, so it can be handed to code that should not otherwise
+// modify the underlying list.
+// The zero value is an empty stack ready to use.
+type Stack[E any] struct {
+	list List[E]
+}
+
+// NewStack returns an empty stack.
+func NewStack[E any]() *Stack[E] { return new(Stack[E]) }
+
+// Len returns the number of values in s.
+func (s *Stack[E]) Len() int { return s.list.Len() }
+
+// Push adds v to the top of s.
+func (s *Stack[E]) Push(v E) { s.list.PushBack(v) }
+
+// Pop removes and returns the value at the top of s, or ok == false if s
+// is empty.
+func (s *Stack[E]) Pop() (v E, ok bool) { return s.list.PopBack() }
+
+// Peek returns the value at the top of s without removing it, or
+// ok == false if s is empty.
+func (s *Stack[E]) Peek() (v E, ok bool) { return s.list.BackValue() }
@@ -0,0 +1,27 @@
+package list
+
+import "testing"
+
+func TestStack(t *testing.T) {
+	var s Stack[int]
+	if _, ok := s.Pop(); ok {
+		t.Errorf("Pop on empty stack ok")
+	}
+	if _, ok := s.Peek(); ok {
+		t.Errorf("Peek on empty stack ok")
+	}
+	for i := range 3 {
+		s.Push(i)
+	}
+	if v, ok := s.Peek(); !ok || v != 2 || s.Len() != 3 {
+		t.Errorf("s.Peek() = %d, %v; len %d", v, ok, s.Len())
+	}
+	for want := 2; want >= 0; want-- {
+		if v, ok := s.Pop(); !ok || v != want {
+			t.Errorf("s.Pop() = %d, %v; want %d, true", v, ok, want)
+		}
+	}
+	if s.Len() != 0 {
+		t.Errorf("s.Len() = %d, want 0", s.Len())
+	}
+}
@@ -0,0 +1,40 @@
+package list
+
+// Stats describes the memory behaviour of a list, for tuning pooling.
+type Stats struct {
+	Len        int    // current number of elements
+	MaxLen     int    // highest number of elements held at once
+	Pooled     int    // removed elements held in the list's pool
+	Allocs     uint64 // elements allocated by the list itself
+	PoolHits   uint64 // insertions that reused a pooled element
+	PoolMisses uint64 // insertions that needed an element from elsewhere
+}
+
+// listCounters holds the counters reported by Stats.
+type listCounters struct {
+	maxLen       int
+	allocs       uint64
+	hits, misses uint64
+}
+
+// Stats returns statistics for list l. Counters accumulate over the
+// lifetime of l and are not reset by Init. PoolMisses includes elements
+// taken from an Arena or the pool shared by NewSyncPooled, which do not
+// count towards Allocs.
+func (l *List[E]) Stats() Stats {
+	return Stats{
+		Len:        l.len,
+		MaxLen:     l.counters.maxLen,
+		Pooled:     len(l.epool),
+		Allocs:     l.counters.allocs,
+		PoolHits:   l.counters.hits,
+		PoolMisses: l.counters.misses,
+	}
+}
+
+// grew records a new length of l for the high-water mark.
+func (l *List[E]) grew() {
+	if l.len > l.counters.maxLen {
+		l.counters.maxLen = l.len
+	}
+}
@@ -0,0 +1,33 @@
+package list
+
+import "testing"
+
+func TestStats(t *testing.T) {
+	var l List[int]
+	for i := range 6 {
+		l.PushBack(i)
+	}
+	for range 5 {
+		l.Remove(l.Front())
+	}
+	l.PushBack(6)
+	l.PushBackSlice([]int{7, 8, 9, 10, 11, 12})
+
+	// 6 single allocations; the pool keeps 4 of the 5 removed elements, 1
+	// reused by PushBack and 3 by the slice, whose rest is a block of 3.
+	want := Stats{Len: 8, MaxLen: 8, Pooled: 0, Allocs: 9, PoolHits: 4, PoolMisses: 9}
+	if got := l.Stats(); got != want {
+		t.Errorf("l.Stats() = %+v, want %+v", got, want)
+	}
+
+	other := New[int]()
+	other.PushBackMany(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	l.PushBackList(other)
+	if got := l.Stats(); got.MaxLen != 18 || got.Len != 18 {
+		t.Errorf("MaxLen, Len = %d, %d; want 18, 18", got.MaxLen, got.Len)
+	}
+	l.Init()
+	if got := l.Stats(); got.Len != 0 || got.MaxLen != 18 {
+		t.Errorf("after Init, Len, MaxLen = %d, %d; want 0, 18", got.Len, got.MaxLen)
+	}
+}
@@ -0,0 +1,149 @@
+package list
+
+import "sync"
+
+// SyncList is a List protected by a read-write mutex, safe for concurrent
+// use by multiple goroutines. The zero value is an empty list ready to use.
+//
+// Elements returned by SyncList methods may be passed back to it, for
+// example to Remove or MoveToFront, but their Next, Prev and Value must only
+// be accessed inside Update or View.
+type SyncList[E any] struct {
+	mu   sync.RWMutex
+	list List[E]
+}
+
+// NewSyncList returns an empty synchronized list.
+func NewSyncList[E any]() *SyncList[E] { return new(SyncList[E]) }
+
+// Len returns the number of elements of s.
+func (s *SyncList[E]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.Len()
+}
+
+// PushFront inserts a new element with value v at the front of s and returns it.
+func (s *SyncList[E]) PushFront(v E) *Element[E] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.PushFront(v)
+}
+
+// PushBack inserts a new element with value v at the back of s and returns it.
+func (s *SyncList[E]) PushBack(v E) *Element[E] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.PushBack(v)
+}
+
+// Remove removes e from s if e is an element of s and returns e.Value.
+// The element must not be nil.
+func (s *SyncList[E]) Remove(e *Element[E]) E {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.Remove(e)
+}
+
+// MoveToFront moves element e to the front of s.
+// If e is not an element of s, the list is not modified.
+func (s *SyncList[E]) MoveToFront(e *Element[E]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.MoveToFront(e)
+}
+
+// MoveToBack moves element e to the back of s.
+// If e is not an element of s, the list is not modified.
+func (s *SyncList[E]) MoveToBack(e *Element[E]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.MoveToBack(e)
+}
+
+// PopFront removes the front element of s and returns its value.
+// If s is empty, PopFront returns the zero value and false.
+func (s *SyncList[E]) PopFront() (E, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.PopFront()
+}
+
+// PopBack removes the back element of s and returns its value.
+// If s is empty, PopBack returns the zero value and false.
+func (s *SyncList[E]) PopBack() (E, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.PopBack()
+}
+
+// FrontValue returns the value of the front element of s.
+// If s is empty, FrontValue returns the zero value and false.
+func (s *SyncList[E]) FrontValue() (E, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.FrontValue()
+}
+
+// BackValue returns the value of the back element of s.
+// If s is empty, BackValue returns the zero value and false.
+func (s *SyncList[E]) BackValue() (E, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.BackValue()
+}
+
+// LenAndFront atomically returns the length of s and the value of its front
+// element. If s is empty, the value is the zero value and ok is false.
+func (s *SyncList[E]) LenAndFront() (n int, v E, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok = s.list.FrontValue()
+	return s.list.Len(), v, ok
+}
+
+// PopFrontIf removes and returns the front value of s if s is not empty and
+// pred reports true for it. The check and the removal happen atomically.
+func (s *SyncList[E]) PopFrontIf(pred func(E) bool) (E, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.list.FrontValue(); !ok || !pred(v) {
+		var zero E
+		return zero, false
+	}
+	return s.list.PopFront()
+}
+
+// Drain atomically removes all elements of s and returns their values
+// from front to back.
+func (s *SyncList[E]) Drain() []E {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	vals := s.list.ToSlice()
+	s.list.Truncate(0)
+	return vals
+}
+
+// ToSlice returns a snapshot of the values of s from front to back.
+func (s *SyncList[E]) ToSlice() []E {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.ToSlice()
+}
+
+// View calls f with the underlying list while holding the read lock.
+// f must not modify the list or retain it after returning.
+func (s *SyncList[E]) View(f func(l *List[E])) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	f(&s.list)
+}
+
+// Update calls f with the underlying list while holding the write lock,
+// allowing arbitrary compound operations to happen atomically.
+// f must not retain the list after returning.
+func (s *SyncList[E]) Update(f func(l *List[E])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(&s.list)
+}
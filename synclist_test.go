@@ -0,0 +1,72 @@
+package list
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSyncList(t *testing.T) {
+	var s SyncList[int]
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				e := s.PushBack(j)
+				s.MoveToFront(e)
+				s.LenAndFront()
+			}
+		}()
+	}
+	wg.Wait()
+	if n := s.Len(); n != 800 {
+		t.Fatalf("s.Len() = %d, want 800", n)
+	}
+
+	popped := 0
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				if _, ok := s.PopFront(); !ok {
+					return
+				}
+				s.Update(func(*List[int]) { popped++ })
+			}
+		}()
+	}
+	wg.Wait()
+	if popped != 800 {
+		t.Errorf("popped %d values, want 800", popped)
+	}
+}
+
+func TestSyncListCompound(t *testing.T) {
+	s := NewSyncList[int]()
+	s.PushBack(1)
+	s.PushBack(2)
+	s.PushFront(0)
+	if n, v, ok := s.LenAndFront(); n != 3 || v != 0 || !ok {
+		t.Errorf("s.LenAndFront() = %d, %d, %v; want 3, 0, true", n, v, ok)
+	}
+	if _, ok := s.PopFrontIf(func(v int) bool { return v > 0 }); ok {
+		t.Errorf("PopFrontIf popped a value failing the predicate")
+	}
+	if v, ok := s.PopFrontIf(func(v int) bool { return v == 0 }); !ok || v != 0 {
+		t.Errorf("PopFrontIf = %d, %v; want 0, true", v, ok)
+	}
+	if got, want := s.Drain(), []int{1, 2}; !slices.Equal(got, want) {
+		t.Errorf("s.Drain() = %v, want %v", got, want)
+	}
+	if n := s.Len(); n != 0 {
+		t.Errorf("s.Len() after Drain = %d, want 0", n)
+	}
+	s.View(func(l *List[int]) {
+		if err := l.CheckInvariants(); err != nil {
+			t.Error(err)
+		}
+	})
+}
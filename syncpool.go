@@ -0,0 +1,36 @@
+package list
+
+import "sync"
+
+// elementPools holds one *sync.Pool of *Element[E] per element type,
+// keyed by a nil *E.
+var elementPools sync.Map
+
+// syncPool recycles elements through a sync.Pool shared by every list of
+// the same element type.
+type syncPool[E any] struct {
+	pool *sync.Pool
+}
+
+// NewSyncPooled returns an initialized list that takes its elements from,
+// and returns removed elements to, a sync.Pool shared by all such lists of
+// the same element type. Short-lived lists, such as those built while
+// handling a request, then stop allocating in steady state. Unlike the
+// per-list pool, the shared pool is safe to use from lists owned by
+// different goroutines, and the runtime frees pooled elements that go
+// unused.
+func NewSyncPooled[E any]() *List[E] {
+	p, ok := elementPools.Load((*E)(nil))
+	if !ok {
+		p, _ = elementPools.LoadOrStore((*E)(nil), &sync.Pool{
+			New: func() any { return new(Element[E]) },
+		})
+	}
+	l := New[E]()
+	l.alloc = syncPool[E]{p.(*sync.Pool)}
+	return l
+}
+
+func (p syncPool[E]) get() *Element[E] { return p.pool.Get().(*Element[E]) }
+
+func (p syncPool[E]) put(e *Element[E]) { p.pool.Put(e) }
@@ -0,0 +1,53 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSyncPooled(t *testing.T) {
+	l := NewSyncPooled[int]()
+	for i := range 10 {
+		l.PushBack(i)
+	}
+	e := l.Front()
+	l.Remove(e)
+	if e.Value != 0 {
+		t.Errorf("pooled element kept value %d", e.Value)
+	}
+	l.PushFrontSlice([]int{-1, 0})
+	if got, want := slices.Collect(l.All()), []int{-1, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9}; !slices.Equal(got, want) {
+		t.Errorf("l.All() = %v, want %v", got, want)
+	}
+	if err := l.CheckInvariants(); err != nil {
+		t.Error(err)
+	}
+
+	// Lists of the same element type share one pool.
+	if NewSyncPooled[int]().alloc != l.alloc {
+		t.Errorf("lists of the same type use different pools")
+	}
+}
+
+// benchmarkShortLived builds and drains a small list per iteration, as a
+// request handler might.
+func benchmarkShortLived(b *testing.B, newList func() *List[int]) {
+	b.ReportAllocs()
+	for range b.N {
+		l := newList()
+		for j := range 16 {
+			l.PushBack(j)
+		}
+		for l.Len() > 0 {
+			l.Remove(l.Front())
+		}
+	}
+}
+
+func BenchmarkShortLivedList(b *testing.B) {
+	benchmarkShortLived(b, New[int])
+}
+
+func BenchmarkShortLivedListSyncPooled(b *testing.B) {
+	benchmarkShortLived(b, NewSyncPooled[int])
+}
@@ -0,0 +1,109 @@
+// Package timerwheel implements a hierarchical timing wheel built on
+// list-go lists. Scheduling and cancelling a timer are O(1), which makes it
+// suitable for tracking very large numbers of timeouts.
+package timerwheel
+
+import (
+	list "github.com/andrewchambers/list-go"
+)
+
+const (
+	slotBits  = 6
+	numSlots  = 1 << slotBits
+	slotMask  = numSlots - 1
+	numLevels = (64 + slotBits - 1) / slotBits
+)
+
+// Wheel is a hierarchical timing wheel driven by explicit calls to Advance.
+// Time is measured in abstract ticks; the caller decides how long a tick
+// is. Level k of the wheel holds timers expiring within 64^(k+1) ticks,
+// and its slots are cascaded into lower levels as time reaches them.
+// The zero value is a wheel at tick 0 ready to use.
+// A Wheel is not safe for concurrent use.
+type Wheel struct {
+	now   uint64
+	len   int
+	slots [numLevels][numSlots]list.List[*Timer]
+}
+
+// Timer is a callback scheduled on a Wheel.
+type Timer struct {
+	at   uint64
+	fn   func()
+	elem *list.Element[*Timer] // nil once fired or cancelled
+}
+
+// New returns a wheel at tick 0.
+func New() *Wheel { return new(Wheel) }
+
+// Now returns the current tick of w.
+func (w *Wheel) Now() uint64 { return w.now }
+
+// Len returns the number of pending timers in w.
+func (w *Wheel) Len() int { return w.len }
+
+// Schedule arranges for fn to be called once w has advanced delay ticks
+// and returns the timer. A delay of 0 is treated as 1, so fn is never
+// called from within Schedule.
+func (w *Wheel) Schedule(delay uint64, fn func()) *Timer {
+	t := &Timer{at: w.now + max(delay, 1), fn: fn}
+	t.elem = w.slotFor(t.at).PushBack(t)
+	w.len++
+	return t
+}
+
+// Cancel stops t from firing and reports whether it was pending.
+func (w *Wheel) Cancel(t *Timer) bool {
+	if t.elem == nil {
+		return false
+	}
+	t.elem.List().Remove(t.elem)
+	t.elem = nil
+	w.len--
+	return true
+}
+
+// Advance moves w forward by ticks, calling the function of each timer as
+// it expires, and returns the number of timers fired. Timers expiring on
+// the same tick fire in the order they were scheduled. The functions may
+// schedule and cancel timers on w.
+func (w *Wheel) Advance(ticks uint64) int {
+	fired := 0
+	for range ticks {
+		w.now++
+		for level := numLevels - 1; level > 0; level-- {
+			if w.now&(1<<(level*slotBits)-1) == 0 {
+				w.cascade(level)
+			}
+		}
+		slot := &w.slots[0][w.now&slotMask]
+		for e := slot.Front(); e != nil; e = slot.Front() {
+			t := slot.Remove(e)
+			t.elem = nil
+			w.len--
+			fired++
+			t.fn()
+		}
+	}
+	return fired
+}
+
+// cascade moves the timers in the current slot of level down to the
+// levels matching their remaining time.
+func (w *Wheel) cascade(level int) {
+	slot := &w.slots[level][w.now>>(level*slotBits)&slotMask]
+	for e := slot.Front(); e != nil; e = slot.Front() {
+		w.slotFor(e.Value.at).AdoptBack(e)
+	}
+}
+
+// slotFor returns the slot for a timer expiring at tick at. The level is
+// that of the most significant slot-sized digit in which at and w.now
+// differ.
+func (w *Wheel) slotFor(at uint64) *list.List[*Timer] {
+	level := 0
+	for diff := at ^ w.now; diff > slotMask; diff >>= slotBits {
+		level++
+	}
+	return &w.slots[level][at>>(level*slotBits)&slotMask]
+}
@@ -0,0 +1,67 @@
+package timerwheel
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestWheel(t *testing.T) {
+	var w Wheel
+	var fired []int
+	for _, d := range []uint64{5, 0, 64, 63, 4096, 70, 5} {
+		w.Schedule(d, func() { fired = append(fired, int(d)) })
+	}
+	cancelled := w.Schedule(10, func() { t.Errorf("cancelled timer fired") })
+	if w.Len() != 8 {
+		t.Errorf("w.Len() = %d, want 8", w.Len())
+	}
+	if !w.Cancel(cancelled) || w.Cancel(cancelled) {
+		t.Errorf("Cancel results wrong")
+	}
+
+	if n := w.Advance(5); n != 3 {
+		t.Errorf("w.Advance(5) fired %d, want 3", n)
+	}
+	w.Advance(5000)
+	if want := []int{0, 5, 5, 63, 64, 70, 4096}; !slices.Equal(fired, want) {
+		t.Errorf("fired %v, want %v", fired, want)
+	}
+	if w.Len() != 0 || w.Now() != 5005 {
+		t.Errorf("Len, Now = %d, %d; want 0, 5005", w.Len(), w.Now())
+	}
+}
+
+func TestWheelExactTicks(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	w := New()
+	w.Advance(12345) // start away from a level boundary
+	for range 2000 {
+		d := r.Uint64N(1 << 20)
+		want := w.Now() + max(d, 1)
+		w.Schedule(d, func() {
+			if w.Now() != want {
+				t.Errorf("timer for tick %d fired at %d", want, w.Now())
+			}
+		})
+	}
+	if n := w.Advance(1 << 20); n != 2000 {
+		t.Errorf("fired %d timers, want 2000", n)
+	}
+}
+
+func TestWheelRescheduleFromCallback(t *testing.T) {
+	w := New()
+	n := 0
+	var tick func()
+	tick = func() {
+		if n++; n < 3 {
+			w.Schedule(100, tick)
+		}
+	}
+	w.Schedule(100, tick)
+	w.Advance(1000)
+	if n != 3 || w.Len() != 0 {
+		t.Errorf("callback ran %d times, %d pending; want 3, 0", n, w.Len())
+	}
+}
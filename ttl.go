@@ -0,0 +1,183 @@
+package list
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// TTLList is a collection of values that each expire at a deadline. It is
+// safe for concurrent use. Expired values are pruned lazily whenever the
+// list is accessed, eagerly by ExpireNow, or periodically by Reap, and are
+// reported to an optional expiry callback.
+// A TTLList must be created with NewTTLList.
+type TTLList[E any] struct {
+	mu       sync.Mutex
+	list     List[ttlEntry[E]] // ordered by deadline
+	onExpire func(E)
+	now      func() time.Time
+}
+
+type ttlEntry[E any] struct {
+	value    E
+	deadline time.Time
+}
+
+// TTLEntry refers to a value added to a TTLList, so that it can be
+// removed or given a new deadline before it expires. An entry that has
+// expired or been removed is stale, and using it has no effect.
+type TTLEntry[E any] struct {
+	h Handle[ttlEntry[E]]
+}
+
+func compareDeadlines[E any](a, b ttlEntry[E]) int {
+	return a.deadline.Compare(b.deadline)
+}
+
+// NewTTLList returns an empty TTL list. If onExpire is not nil, it is
+// called with each value that expires, outside the list's lock.
+func NewTTLList[E any](onExpire func(E)) *TTLList[E] {
+	return &TTLList[E]{onExpire: onExpire, now: time.Now}
+}
+
+// Push adds v to t, expiring ttl from now, and returns its entry.
+func (t *TTLList[E]) Push(v E, ttl time.Duration) TTLEntry[E] {
+	return t.PushDeadline(v, t.now().Add(ttl))
+}
+
+// PushDeadline adds v to t, expiring at deadline, and returns its entry.
+func (t *TTLList[E]) PushDeadline(v E, deadline time.Time) TTLEntry[E] {
+	t.mu.Lock()
+	e := t.list.InsertSortedFunc(ttlEntry[E]{v, deadline}, compareDeadlines)
+	h := t.list.Handle(e)
+	expired := t.pruneLocked()
+	t.mu.Unlock()
+	t.report(expired)
+	return TTLEntry[E]{h}
+}
+
+// Remove removes the value of entry e from t without reporting it to the
+// expiry callback, and reports whether it was still unexpired.
+func (t *TTLList[E]) Remove(e TTLEntry[E]) bool {
+	t.mu.Lock()
+	expired := t.pruneLocked()
+	elem, err := t.list.Resolve(e.h)
+	if err == nil {
+		t.list.Remove(elem)
+	}
+	t.mu.Unlock()
+	t.report(expired)
+	return err == nil
+}
+
+// Touch sets the deadline of entry e to ttl from now, and reports whether
+// its value was still unexpired.
+func (t *TTLList[E]) Touch(e TTLEntry[E], ttl time.Duration) bool {
+	return t.TouchDeadline(e, t.now().Add(ttl))
+}
+
+// TouchDeadline sets the deadline of entry e, and reports whether its
+// value was still unexpired.
+func (t *TTLList[E]) TouchDeadline(e TTLEntry[E], deadline time.Time) bool {
+	t.mu.Lock()
+	expired := t.pruneLocked()
+	elem, err := t.list.Resolve(e.h)
+	if err == nil {
+		elem.Value.deadline = deadline
+		// Keep t ordered, placing elem after any equal deadlines.
+		at := t.list.Back()
+		for at != nil && (at == elem || at.Value.deadline.After(deadline)) {
+			at = at.Prev()
+		}
+		if at == nil {
+			t.list.MoveToFront(elem)
+		} else {
+			t.list.MoveAfter(elem, at)
+		}
+		expired = append(expired, t.pruneLocked()...)
+	}
+	t.mu.Unlock()
+	t.report(expired)
+	return err == nil
+}
+
+// Len returns the number of unexpired values in t.
+func (t *TTLList[E]) Len() int {
+	t.mu.Lock()
+	expired := t.pruneLocked()
+	n := t.list.Len()
+	t.mu.Unlock()
+	t.report(expired)
+	return n
+}
+
+// Front returns the unexpired value of t with the earliest deadline and
+// that deadline, or ok == false if t holds no unexpired values.
+func (t *TTLList[E]) Front() (v E, deadline time.Time, ok bool) {
+	t.mu.Lock()
+	expired := t.pruneLocked()
+	if e := t.list.Front(); e != nil {
+		v, deadline, ok = e.Value.value, e.Value.deadline, true
+	}
+	t.mu.Unlock()
+	t.report(expired)
+	return v, deadline, ok
+}
+
+// Values returns the unexpired values of t in order of deadline.
+func (t *TTLList[E]) Values() []E {
+	t.mu.Lock()
+	expired := t.pruneLocked()
+	vs := make([]E, 0, t.list.Len())
+	for ent := range t.list.All() {
+		vs = append(vs, ent.value)
+	}
+	t.mu.Unlock()
+	t.report(expired)
+	return vs
+}
+
+// ExpireNow removes every value whose deadline has passed, reports it to
+// the expiry callback and returns how many were removed.
+func (t *TTLList[E]) ExpireNow() int {
+	t.mu.Lock()
+	expired := t.pruneLocked()
+	t.mu.Unlock()
+	t.report(expired)
+	return len(expired)
+}
+
+// Reap calls ExpireNow every interval until ctx is done, and then returns
+// ctx.Err(). It is intended to be run in its own goroutine.
+func (t *TTLList[E]) Reap(ctx context.Context, interval time.Duration) error {
+	tick := time.NewTicker(interval)
+	defer tick.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-tick.C:
+			t.ExpireNow()
+		}
+	}
+}
+
+// pruneLocked removes the expired values at the front of t and returns
+// them. t.mu must be held.
+func (t *TTLList[E]) pruneLocked() []E {
+	var expired []E
+	now := t.now()
+	for e := t.list.Front(); e != nil && !e.Value.deadline.After(now); e = t.list.Front() {
+		expired = append(expired, t.list.Remove(e).value)
+	}
+	return expired
+}
+
+func (t *TTLList[E]) report(expired []E) {
+	if t.onExpire == nil {
+		return
+	}
+	for _, v := range expired {
+		t.onExpire(v)
+	}
+}
@@ -0,0 +1,106 @@
+package list
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestTTLList(t *testing.T) {
+	now := time.Unix(1000, 0)
+	var expired []string
+	l := NewTTLList(func(v string) { expired = append(expired, v) })
+	l.now = func() time.Time { return now }
+
+	l.Push("b", 2*time.Second)
+	l.Push("c", 3*time.Second)
+	l.Push("a", time.Second)
+	if got, want := l.Values(), []string{"a", "b", "c"}; !slices.Equal(got, want) {
+		t.Errorf("l.Values() = %v, want %v", got, want)
+	}
+	if v, d, ok := l.Front(); !ok || v != "a" || !d.Equal(now.Add(time.Second)) {
+		t.Errorf("l.Front() = %q, %v, %v", v, d, ok)
+	}
+
+	// Values expire lazily on access, at their deadline.
+	now = now.Add(2 * time.Second)
+	if n := l.Len(); n != 1 {
+		t.Errorf("l.Len() = %d, want 1", n)
+	}
+	if want := []string{"a", "b"}; !slices.Equal(expired, want) {
+		t.Errorf("expired %v, want %v", expired, want)
+	}
+	if n := l.ExpireNow(); n != 0 {
+		t.Errorf("l.ExpireNow() = %d, want 0", n)
+	}
+	now = now.Add(time.Hour)
+	if n := l.ExpireNow(); n != 1 {
+		t.Errorf("l.ExpireNow() = %d, want 1", n)
+	}
+	if _, _, ok := l.Front(); ok {
+		t.Errorf("l.Front() ok on expired list")
+	}
+}
+
+func TestTTLListRemoveTouch(t *testing.T) {
+	now := time.Unix(1000, 0)
+	var expired []string
+	l := NewTTLList(func(v string) { expired = append(expired, v) })
+	l.now = func() time.Time { return now }
+
+	a := l.Push("a", time.Second)
+	b := l.Push("b", 2*time.Second)
+	c := l.Push("c", 3*time.Second)
+	if !l.Remove(b) {
+		t.Errorf("l.Remove(b) = false, want true")
+	}
+	if l.Remove(b) {
+		t.Errorf("second l.Remove(b) = true, want false")
+	}
+	if !l.Touch(a, 5*time.Second) {
+		t.Errorf("l.Touch(a) = false, want true")
+	}
+	if got, want := l.Values(), []string{"c", "a"}; !slices.Equal(got, want) {
+		t.Errorf("l.Values() = %v, want %v", got, want)
+	}
+
+	now = now.Add(4 * time.Second)
+	if got, want := l.Values(), []string{"a"}; !slices.Equal(got, want) {
+		t.Errorf("l.Values() = %v, want %v", got, want)
+	}
+	if want := []string{"c"}; !slices.Equal(expired, want) {
+		t.Errorf("expired %v, want %v", expired, want)
+	}
+	if l.Touch(c, time.Hour) || l.Remove(c) {
+		t.Errorf("expired entry c was still usable")
+	}
+
+	// Entries of reused elements stay stale.
+	d := l.Push("d", time.Hour)
+	if !l.TouchDeadline(d, now) || l.Touch(c, time.Hour) {
+		t.Errorf("touching d affected c")
+	}
+	if want := []string{"c", "d"}; !slices.Equal(expired, want) {
+		t.Errorf("expired %v, want %v", expired, want)
+	}
+}
+
+func TestTTLListReap(t *testing.T) {
+	done := make(chan struct{})
+	l := NewTTLList(func(int) { close(done) })
+	l.PushDeadline(1, time.Now())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error)
+	go func() { errc <- l.Reap(ctx, time.Millisecond) }()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Reap did not expire value")
+	}
+	cancel()
+	if err := <-errc; err != context.Canceled {
+		t.Errorf("l.Reap() = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,168 @@
+package list
+
+import "errors"
+
+// ErrStaleTxn is returned when undoing or redoing a transaction whose list
+// has been modified outside the transaction since it ended.
+var ErrStaleTxn = errors.New("list: list modified since transaction ended")
+
+// Txn is a transaction on a list, started by Begin. It records every
+// structural change made to the list until it is committed or rolled
+// back, so that the changes can be undone and redone as a unit.
+//
+// Undo works on values and positions: values removed during the
+// transaction are restored in new elements, and undoing a rearrangement
+// such as Sort rebuilds the list from its earlier values. Element
+// pointers to restored values therefore differ from the originals.
+type Txn[E any] struct {
+	l      *List[E]
+	ops    []txnOp[E]
+	active bool
+	undone bool
+	mod    uint64 // l.mod when the transaction last ended
+}
+
+type txnOp[E any] struct {
+	Change[E]
+	before []E // contents before a reset
+}
+
+// Begin starts a transaction on list l. Begin panics if a transaction is
+// already in progress on l.
+//
+// While the transaction is in progress, recording a change finds its
+// position by walking from the nearer end of l, so changes at the ends
+// stay O(1) while a change in the middle of l costs O(n). Rearranging l
+// wholesale, as Sort and Reverse do, also copies its values.
+func (l *List[E]) Begin() *Txn[E] {
+	h := l.hookFuncs()
+	if h.txn != nil {
+		panic("list: transaction already in progress")
+	}
+	l.lazyInit()
+	h.txn = &Txn[E]{l: l, active: true}
+	return h.txn
+}
+
+// end stops recording changes.
+func (t *Txn[E]) end() {
+	if t.active {
+		t.active = false
+		t.l.hooks.txn = nil
+		t.l.dropHooks()
+	}
+	t.mod = t.l.mod
+}
+
+// Commit ends the transaction, keeping its changes. A committed
+// transaction can still be undone with Rollback while the list is not
+// modified further.
+func (t *Txn[E]) Commit() {
+	if t.active {
+		t.end()
+	}
+}
+
+// Rollback ends the transaction if it is in progress and undoes its
+// changes. It returns ErrStaleTxn if the transaction had already ended and
+// the list has been modified since, and does nothing if the changes are
+// already undone.
+func (t *Txn[E]) Rollback() error {
+	if !t.active && t.l.mod != t.mod {
+		return ErrStaleTxn
+	}
+	t.end()
+	if t.undone {
+		return nil
+	}
+	for i := len(t.ops) - 1; i >= 0; i-- {
+		op := &t.ops[i]
+		switch op.Kind {
+		case ChangeInsert:
+			t.l.removeAt(op.Index, len(op.Values))
+		case ChangeRemove:
+			t.l.insertAt(op.Index, op.Values)
+		case ChangeMove:
+			t.l.moveAt(op.Index, op.From, len(op.Values))
+		case ChangeReset:
+			t.l.resetTo(op.before)
+		}
+	}
+	t.undone = true
+	t.mod = t.l.mod
+	return nil
+}
+
+// Redo reapplies the changes of a rolled back transaction. It returns
+// ErrStaleTxn if the list has been modified since the rollback, and does
+// nothing if the changes are not undone.
+func (t *Txn[E]) Redo() error {
+	if t.active || !t.undone {
+		return nil
+	}
+	if t.l.mod != t.mod {
+		return ErrStaleTxn
+	}
+	for _, op := range t.ops {
+		switch op.Kind {
+		case ChangeInsert:
+			t.l.insertAt(op.Index, op.Values)
+		case ChangeRemove:
+			t.l.removeAt(op.Index, len(op.Values))
+		case ChangeMove:
+			t.l.moveAt(op.From, op.Index, len(op.Values))
+		case ChangeReset:
+			t.l.resetTo(op.Values)
+		}
+	}
+	t.undone = false
+	t.mod = t.l.mod
+	return nil
+}
+
+// predecessor returns the element after which a value lands at index i.
+func (l *List[E]) predecessor(i int) *Element[E] {
+	if i == 0 {
+		return &l.root
+	}
+	return l.At(i - 1)
+}
+
+func (l *List[E]) insertAt(i int, vals []E) {
+	l.insertSlice(vals, l.predecessor(i))
+}
+
+func (l *List[E]) removeAt(i, n int) {
+	e := l.At(i)
+	for ; n > 0; n-- {
+		next := e.next
+		l.remove(e)
+		e = next
+	}
+}
+
+// moveAt moves the n elements at index from so that the first lands at
+// index to. Like removeAt and insertAt it locates its elements with At, so
+// replaying an operation walks from the nearer end of the list; a Cursor
+// would not help, as every replayed operation modifies the list.
+func (l *List[E]) moveAt(from, to, n int) {
+	first := l.At(from)
+	last := walk(first, from, from+n-1)
+	at := l.predecessor(to)
+	if to > from {
+		// Positions after the range shift down once it is taken out.
+		at = l.At(to + n - 1)
+	}
+	if at != last {
+		l.spliceRange(at, first, last, n, l)
+	}
+}
+
+// resetTo replaces the contents of l with vals. Unlike Init, it keeps the
+// pool of l, so the removed elements are reused.
+func (l *List[E]) resetTo(vals []E) {
+	for l.len > 0 {
+		l.remove(l.root.prev)
+	}
+	l.insertSlice(vals, &l.root)
+}
@@ -0,0 +1,133 @@
+package list
+
+import (
+	"cmp"
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestTxnRollbackRedo(t *testing.T) {
+	r := rand.New(rand.NewPCG(11, 12))
+	l := New[int]()
+	l.PushBackMany(1, 2, 3, 4, 5)
+
+	ops := []func(){
+		func() { l.PushBack(r.IntN(100)) },
+		func() { l.PushFront(r.IntN(100)) },
+		func() {
+			if e := l.At(r.IntN(l.Len() + 1)); e != nil {
+				l.Remove(e)
+			}
+		},
+		func() {
+			if l.Len() > 1 {
+				l.MoveAfter(l.At(r.IntN(l.Len())), l.At(r.IntN(l.Len())))
+			}
+		},
+		func() {
+			if l.Len() > 3 {
+				l.MoveRangeBefore(l.At(1), l.At(2), l.Front())
+			}
+		},
+		func() {
+			if l.Len() > 3 {
+				l.MoveRangeBefore(l.At(0), l.At(1), l.Back())
+			}
+		},
+		func() {
+			if l.Len() > 2 {
+				l.SplitBefore(l.At(l.Len() - 2))
+			}
+		},
+		func() { l.Rotate(r.IntN(5)) },
+		func() { l.Reverse() },
+		func() { l.SortFunc(cmp.Compare[int]) },
+		func() {
+			other := New[int]()
+			other.PushBackMany(50, 10)
+			l.MergeFunc(other, cmp.Compare[int])
+		},
+		func() {
+			if r.IntN(10) == 0 {
+				l.Init()
+			}
+		},
+	}
+	for range 20 {
+		before := l.ToSlice()
+		txn := l.Begin()
+		for range 30 {
+			ops[r.IntN(len(ops))]()
+		}
+		txn.Commit()
+		after := l.ToSlice()
+
+		if err := txn.Rollback(); err != nil {
+			t.Fatalf("Rollback: %v", err)
+		}
+		if got := l.ToSlice(); !slices.Equal(got, before) {
+			t.Fatalf("after Rollback = %v, want %v", got, before)
+		}
+		if err := txn.Redo(); err != nil {
+			t.Fatalf("Redo: %v", err)
+		}
+		if got := l.ToSlice(); !slices.Equal(got, after) {
+			t.Fatalf("after Redo = %v, want %v", got, after)
+		}
+	}
+	if l.hooks != nil {
+		t.Errorf("hooks kept after transactions ended")
+	}
+}
+
+func TestTxnStale(t *testing.T) {
+	l := New[int]()
+	l.PushBackMany(1, 2, 3)
+	txn := l.Begin()
+	l.PushBack(4)
+	if err := txn.Rollback(); err != nil {
+		t.Fatalf("Rollback of active transaction: %v", err)
+	}
+	if got := l.ToSlice(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("after Rollback = %v, want [1 2 3]", got)
+	}
+	l.PushBack(5)
+	if err := txn.Redo(); !errors.Is(err, ErrStaleTxn) {
+		t.Errorf("Redo after modification = %v, want ErrStaleTxn", err)
+	}
+
+	txn = l.Begin()
+	l.Remove(l.Front())
+	txn.Commit()
+	l.PushFront(0)
+	if err := txn.Rollback(); !errors.Is(err, ErrStaleTxn) {
+		t.Errorf("Rollback after modification = %v, want ErrStaleTxn", err)
+	}
+}
+
+func TestTxnBeginTwice(t *testing.T) {
+	l := New[int]()
+	l.Begin()
+	mustPanic(t, "second Begin", func() { l.Begin() })
+}
+
+func TestTxnKeepsPool(t *testing.T) {
+	l := New[int]()
+	l.PushBackMany(3, 1, 2)
+	l.Reserve(8)
+	txn := l.Begin()
+	l.SortFunc(cmp.Compare[int])
+	txn.Commit()
+	allocs := l.Stats().Allocs
+	if err := txn.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	if got := l.ToSlice(); !slices.Equal(got, []int{3, 1, 2}) {
+		t.Errorf("after Rollback = %v, want [3 1 2]", got)
+	}
+	if got := l.Stats().Allocs; got != allocs {
+		t.Errorf("Rollback allocated %d elements", got-allocs)
+	}
+}
@@ -0,0 +1,338 @@
+package list
+
+import "iter"
+
+// unrolledNodeSize is the number of values held by each UnrolledList node.
+const unrolledNodeSize = 16
+
+// UnrolledList is a list that stores up to 16 values per node. Compared
+// with a List it needs a fraction of the pointers per value and keeps
+// neighbouring values adjacent in memory, which speeds up traversal.
+// Values are referred to by UElement, like the elements of a List, or by
+// index. Since inserting and removing values shifts their neighbours
+// within a node, an UElement is only valid until the list is next
+// modified.
+// The zero value is an empty list ready to use.
+// An UnrolledList is not safe for concurrent use.
+type UnrolledList[E any] struct {
+	head, tail *unrolledNode[E]
+	len        int
+	mod        uint64 // insertions and removals, invalidating elements
+}
+
+type unrolledNode[E any] struct {
+	vals       [unrolledNodeSize]E
+	n          int // number of values in use, always at least 1
+	prev, next *unrolledNode[E]
+}
+
+// UElement is a reference to a value of an UnrolledList. It is a small
+// value rather than a pointer, so walking a list with Next or Prev does not
+// allocate. The zero UElement refers to no value and is returned past the
+// ends of the list; see IsZero. An element remains valid until the list is
+// next modified, except that an element returned by the modifying
+// operation refers to the value it inserted. Using an element after that
+// panics.
+type UElement[E any] struct {
+	list *UnrolledList[E]
+	node *unrolledNode[E]
+	off  int
+	mod  uint64 // list.mod when e was created
+}
+
+// IsZero reports whether e is the zero UElement, which refers to no value.
+func (e UElement[E]) IsZero() bool { return e.list == nil }
+
+// check panics if e is the zero UElement or has been invalidated by a
+// modification of its list.
+func (e UElement[E]) check() {
+	if e.list == nil {
+		panic("list: use of zero UElement")
+	}
+	if e.mod != e.list.mod {
+		panic("list: UElement used after its list was modified")
+	}
+}
+
+// Value returns the value e refers to.
+func (e UElement[E]) Value() E {
+	e.check()
+	return e.node.vals[e.off]
+}
+
+// SetValue sets the value e refers to. It does not invalidate elements.
+func (e UElement[E]) SetValue(v E) {
+	e.check()
+	e.node.vals[e.off] = v
+}
+
+// Next returns the next element or the zero UElement.
+func (e UElement[E]) Next() UElement[E] {
+	e.check()
+	switch {
+	case e.off+1 < e.node.n:
+		return e.list.elem(e.node, e.off+1)
+	case e.node.next != nil:
+		return e.list.elem(e.node.next, 0)
+	}
+	return UElement[E]{}
+}
+
+// Prev returns the previous element or the zero UElement.
+func (e UElement[E]) Prev() UElement[E] {
+	e.check()
+	switch {
+	case e.off > 0:
+		return e.list.elem(e.node, e.off-1)
+	case e.node.prev != nil:
+		return e.list.elem(e.node.prev, e.node.prev.n-1)
+	}
+	return UElement[E]{}
+}
+
+func (l *UnrolledList[E]) elem(n *unrolledNode[E], off int) UElement[E] {
+	return UElement[E]{l, n, off, l.mod}
+}
+
+// NewUnrolledList returns an empty unrolled list.
+func NewUnrolledList[E any]() *UnrolledList[E] { return new(UnrolledList[E]) }
+
+// Len returns the number of values in l.
+func (l *UnrolledList[E]) Len() int { return l.len }
+
+// Front returns the first element of l or the zero UElement if l is
+// empty.
+func (l *UnrolledList[E]) Front() UElement[E] {
+	if l.len == 0 {
+		return UElement[E]{}
+	}
+	return l.elem(l.head, 0)
+}
+
+// Back returns the last element of l or the zero UElement if l is empty.
+func (l *UnrolledList[E]) Back() UElement[E] {
+	if l.len == 0 {
+		return UElement[E]{}
+	}
+	return l.elem(l.tail, l.tail.n-1)
+}
+
+// find returns the node holding index i, which must be in [0, l.len), and
+// the offset of i within it. The nodes are walked from the nearer end.
+func (l *UnrolledList[E]) find(i int) (*unrolledNode[E], int) {
+	if i < l.len/2 {
+		n := l.head
+		for i >= n.n {
+			i -= n.n
+			n = n.next
+		}
+		return n, i
+	}
+	n, r := l.tail, l.len-1-i // r counts back from the last value
+	for r >= n.n {
+		r -= n.n
+		n = n.prev
+	}
+	return n, n.n - 1 - r
+}
+
+// At returns the element at index i of l, or the zero UElement if i is
+// out of range.
+func (l *UnrolledList[E]) At(i int) UElement[E] {
+	if i < 0 || i >= l.len {
+		return UElement[E]{}
+	}
+	n, off := l.find(i)
+	return l.elem(n, off)
+}
+
+// PushFront inserts a new element with value v at the front of l and
+// returns it.
+func (l *UnrolledList[E]) PushFront(v E) UElement[E] {
+	if l.head == nil || l.head.n == unrolledNodeSize {
+		l.linkAfter(nil)
+	}
+	return l.insertIn(l.head, 0, v)
+}
+
+// PushBack inserts a new element with value v at the back of l and
+// returns it.
+func (l *UnrolledList[E]) PushBack(v E) UElement[E] {
+	if l.tail == nil || l.tail.n == unrolledNodeSize {
+		l.linkAfter(l.tail)
+	}
+	return l.insertIn(l.tail, l.tail.n, v)
+}
+
+// InsertBefore inserts a new element with value v immediately before mark
+// and returns it. If mark is not an element of l, the list is not
+// modified and InsertBefore returns the zero UElement.
+func (l *UnrolledList[E]) InsertBefore(v E, mark UElement[E]) UElement[E] {
+	if mark.list != l {
+		return UElement[E]{}
+	}
+	mark.check()
+	return l.insertIn(mark.node, mark.off, v)
+}
+
+// InsertAfter inserts a new element with value v immediately after mark
+// and returns it. If mark is not an element of l, the list is not
+// modified and InsertAfter returns the zero UElement.
+func (l *UnrolledList[E]) InsertAfter(v E, mark UElement[E]) UElement[E] {
+	if mark.list != l {
+		return UElement[E]{}
+	}
+	mark.check()
+	return l.insertIn(mark.node, mark.off+1, v)
+}
+
+// Remove removes e from l if e is an element of l and returns its value.
+func (l *UnrolledList[E]) Remove(e UElement[E]) E {
+	if e.list != l {
+		var zero E
+		return zero
+	}
+	e.check()
+	return l.removeIn(e.node, e.off)
+}
+
+// InsertAt inserts v at index i of l, shifting later values up, and
+// reports whether i was in the range [0, l.Len()].
+func (l *UnrolledList[E]) InsertAt(i int, v E) bool {
+	switch {
+	case i < 0 || i > l.len:
+		return false
+	case i == l.len:
+		l.PushBack(v)
+	default:
+		n, off := l.find(i)
+		l.insertIn(n, off, v)
+	}
+	return true
+}
+
+// RemoveAt removes the value at index i of l, shifting later values down,
+// and returns it, or returns ok == false if i is out of range.
+func (l *UnrolledList[E]) RemoveAt(i int) (v E, ok bool) {
+	if i < 0 || i >= l.len {
+		return v, false
+	}
+	n, off := l.find(i)
+	return l.removeIn(n, off), true
+}
+
+// removeIn removes the value at offset off of node n and returns it.
+func (l *UnrolledList[E]) removeIn(n *unrolledNode[E], off int) E {
+	v := n.vals[off]
+	copy(n.vals[off:], n.vals[off+1:n.n])
+	n.n--
+	var zero E
+	n.vals[n.n] = zero
+	l.len--
+	l.mod++
+	switch {
+	case n.n == 0:
+		l.unlink(n)
+	case n.next != nil && n.n+n.next.n <= unrolledNodeSize/2:
+		l.merge(n)
+	case n.prev != nil && n.prev.n+n.n <= unrolledNodeSize/2:
+		l.merge(n.prev)
+	}
+	return v
+}
+
+// PopFront removes and returns the first value of l, or ok == false if l
+// is empty.
+func (l *UnrolledList[E]) PopFront() (v E, ok bool) { return l.RemoveAt(0) }
+
+// PopBack removes and returns the last value of l, or ok == false if l is
+// empty.
+func (l *UnrolledList[E]) PopBack() (v E, ok bool) { return l.RemoveAt(l.len - 1) }
+
+// insertIn inserts v at offset off of node n, splitting n first if it is
+// full, and returns its element.
+func (l *UnrolledList[E]) insertIn(n *unrolledNode[E], off int, v E) UElement[E] {
+	if n.n == unrolledNodeSize {
+		const half = unrolledNodeSize / 2
+		m := l.linkAfter(n)
+		copy(m.vals[:], n.vals[half:])
+		m.n = unrolledNodeSize - half
+		clear(n.vals[half:])
+		n.n = half
+		if off > half {
+			n, off = m, off-half
+		}
+	}
+	copy(n.vals[off+1:n.n+1], n.vals[off:n.n])
+	n.vals[off] = v
+	n.n++
+	l.len++
+	l.mod++
+	return l.elem(n, off)
+}
+
+// linkAfter links a new empty node after n, or at the front if n is nil,
+// and returns it. The caller must fill it.
+func (l *UnrolledList[E]) linkAfter(n *unrolledNode[E]) *unrolledNode[E] {
+	m := &unrolledNode[E]{prev: n}
+	if n == nil {
+		m.next = l.head
+		l.head = m
+	} else {
+		m.next = n.next
+		n.next = m
+	}
+	if m.next == nil {
+		l.tail = m
+	} else {
+		m.next.prev = m
+	}
+	return m
+}
+
+func (l *UnrolledList[E]) unlink(n *unrolledNode[E]) {
+	if n.prev == nil {
+		l.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next == nil {
+		l.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+}
+
+// merge moves the values of the node after n into n and unlinks it.
+func (l *UnrolledList[E]) merge(n *unrolledNode[E]) {
+	m := n.next
+	copy(n.vals[n.n:], m.vals[:m.n])
+	n.n += m.n
+	l.unlink(m)
+}
+
+// All returns an iterator over the values of l from front to back.
+func (l *UnrolledList[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for n := l.head; n != nil; n = n.next {
+			for _, v := range n.vals[:n.n] {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the values of l from back to front.
+func (l *UnrolledList[E]) Backward() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for n := l.tail; n != nil; n = n.prev {
+			for i := n.n - 1; i >= 0; i-- {
+				if !yield(n.vals[i]) {
+					return
+				}
+			}
+		}
+	}
+}
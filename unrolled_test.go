@@ -0,0 +1,165 @@
+package list
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func checkUnrolled(t *testing.T, l *UnrolledList[int], want []int) {
+	t.Helper()
+	if got := slices.Collect(l.All()); !slices.Equal(got, want) {
+		t.Fatalf("l.All() = %v, want %v", got, want)
+	}
+	back := slices.Clone(want)
+	slices.Reverse(back)
+	if got := slices.Collect(l.Backward()); !slices.Equal(got, back) {
+		t.Fatalf("l.Backward() = %v, want %v", got, back)
+	}
+	if l.Len() != len(want) {
+		t.Fatalf("l.Len() = %d, want %d", l.Len(), len(want))
+	}
+	for n := l.head; n != nil; n = n.next {
+		if n.n < 1 || n.n > unrolledNodeSize {
+			t.Fatalf("node holds %d values", n.n)
+		}
+		if n.next == nil && n != l.tail || n.next != nil && n.next.prev != n {
+			t.Fatalf("node links broken")
+		}
+	}
+}
+
+func TestUnrolledList(t *testing.T) {
+	r := rand.New(rand.NewPCG(7, 8))
+	var l UnrolledList[int]
+	if _, ok := l.PopBack(); ok {
+		t.Errorf("PopBack on empty list ok")
+	}
+	var want []int
+	for v := range 3000 {
+		switch op := r.IntN(6); {
+		case op == 0:
+			l.PushFront(v)
+			want = slices.Insert(want, 0, v)
+		case op == 1:
+			l.PushBack(v)
+			want = append(want, v)
+		case op == 2:
+			i := r.IntN(len(want) + 1)
+			l.InsertAt(i, v)
+			want = slices.Insert(want, i, v)
+		case op == 3 && len(want) > 0:
+			i := r.IntN(len(want))
+			if got, ok := l.RemoveAt(i); !ok || got != want[i] {
+				t.Fatalf("l.RemoveAt(%d) = %d, %v; want %d", i, got, ok, want[i])
+			}
+			want = slices.Delete(want, i, i+1)
+		case op == 4 && len(want) > 0:
+			i := r.IntN(len(want))
+			e := l.At(i)
+			if got := e.Value(); got != want[i] {
+				t.Fatalf("l.At(%d).Value() = %d, want %d", i, got, want[i])
+			}
+			e.SetValue(-v)
+			want[i] = -v
+		}
+	}
+	checkUnrolled(t, &l, want)
+
+	if v := l.Front().Value(); v != want[0] {
+		t.Errorf("l.Front().Value() = %d, want %d", v, want[0])
+	}
+	if v := l.Back().Value(); v != want[len(want)-1] {
+		t.Errorf("l.Back().Value() = %d, want %d", v, want[len(want)-1])
+	}
+	if l.InsertAt(-1, 0) || l.InsertAt(l.Len()+1, 0) || !l.At(l.Len()).IsZero() {
+		t.Errorf("out of range index accepted")
+	}
+	for len(want) > 0 {
+		if v, ok := l.PopFront(); !ok || v != want[0] {
+			t.Fatalf("l.PopFront() = %d, %v; want %d", v, ok, want[0])
+		}
+		want = want[1:]
+	}
+	checkUnrolled(t, &l, nil)
+	if l.head != nil || l.tail != nil {
+		t.Errorf("empty list still has nodes")
+	}
+}
+
+func TestUnrolledElements(t *testing.T) {
+	var l UnrolledList[int]
+	if !l.Front().IsZero() || !l.Back().IsZero() {
+		t.Errorf("empty list has elements")
+	}
+	var want []int
+	e := l.PushBack(0)
+	want = append(want, 0)
+	// Insert around the last inserted element, splitting nodes on the way.
+	for v := 1; v < 100; v++ {
+		if v%3 == 0 {
+			e = l.InsertBefore(v, e)
+			want = slices.Insert(want, slices.Index(want, v-1), v)
+		} else {
+			e = l.InsertAfter(v, e)
+			want = slices.Insert(want, slices.Index(want, v-1)+1, v)
+		}
+		if e.Value() != v {
+			t.Fatalf("inserted element holds %d, want %d", e.Value(), v)
+		}
+	}
+	checkUnrolled(t, &l, want)
+
+	var got []int
+	for e := l.Front(); !e.IsZero(); e = e.Next() {
+		got = append(got, e.Value())
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("forward walk = %v, want %v", got, want)
+	}
+	got = got[:0]
+	for e := l.Back(); !e.IsZero(); e = e.Prev() {
+		got = append(got, e.Value())
+	}
+	slices.Reverse(got)
+	if !slices.Equal(got, want) {
+		t.Errorf("backward walk = %v, want %v", got, want)
+	}
+
+	if v := l.Remove(l.At(10)); v != want[10] {
+		t.Errorf("l.Remove(l.At(10)) = %d, want %d", v, want[10])
+	}
+	want = slices.Delete(want, 10, 11)
+	checkUnrolled(t, &l, want)
+
+	var other UnrolledList[int]
+	o := other.PushBack(1)
+	if !l.InsertAfter(2, o).IsZero() || !l.InsertBefore(2, o).IsZero() || l.Remove(o) != 0 {
+		t.Errorf("foreign element accepted")
+	}
+	checkUnrolled(t, &l, want)
+
+	stale := l.Front()
+	l.PushBack(5)
+	mustPanic(t, "stale element", func() { stale.Value() })
+	mustPanic(t, "zero element", func() { UElement[int]{}.Value() })
+}
+
+func TestUnrolledWalkAllocs(t *testing.T) {
+	var l UnrolledList[int]
+	for v := range 100 {
+		l.PushBack(v)
+	}
+	var sum int
+	allocs := testing.AllocsPerRun(10, func() {
+		for e := l.Front(); !e.IsZero(); e = e.Next() {
+			sum += e.Value()
+		}
+		for e := l.Back(); !e.IsZero(); e = e.Prev() {
+			sum -= e.Value()
+		}
+	})
+	if allocs != 0 || sum != 0 {
+		t.Errorf("walking the list: %v allocations, sum %d; want 0, 0", allocs, sum)
+	}
+}
@@ -0,0 +1,108 @@
+package list
+
+import "sync/atomic"
+
+// WorkStealingDeque is an unbounded Chase-Lev work-stealing deque for
+// scheduler implementations. A single owner goroutine pushes and pops values
+// at the bottom; any number of other goroutines may concurrently Steal values
+// from the top. Push and Pop must only be called by the owner.
+//
+// The zero value is an empty deque ready to use. A WorkStealingDeque must not
+// be copied after first use.
+type WorkStealingDeque[E any] struct {
+	top    atomic.Int64
+	bottom atomic.Int64
+	ring   atomic.Pointer[wsRing[E]]
+}
+
+// wsRing is a circular buffer of boxed values. Slots are accessed
+// atomically because thieves may read a slot the owner is about to reuse.
+type wsRing[E any] struct {
+	slots []atomic.Pointer[E]
+}
+
+func (r *wsRing[E]) slot(i int64) *atomic.Pointer[E] {
+	return &r.slots[i&int64(len(r.slots)-1)]
+}
+
+// grow returns a ring twice the size of r holding the values in [t, b).
+func (r *wsRing[E]) grow(t, b int64) *wsRing[E] {
+	n := &wsRing[E]{slots: make([]atomic.Pointer[E], 2*len(r.slots))}
+	for i := t; i < b; i++ {
+		n.slot(i).Store(r.slot(i).Load())
+	}
+	return n
+}
+
+// Len returns the approximate number of values in d.
+func (d *WorkStealingDeque[E]) Len() int {
+	n := d.bottom.Load() - d.top.Load()
+	if n < 0 {
+		return 0
+	}
+	return int(n)
+}
+
+// Push adds v to the bottom of d. It must only be called by the owner.
+func (d *WorkStealingDeque[E]) Push(v E) {
+	const minRing = 32
+	b := d.bottom.Load()
+	t := d.top.Load()
+	r := d.ring.Load()
+	if r == nil {
+		r = &wsRing[E]{slots: make([]atomic.Pointer[E], minRing)}
+		d.ring.Store(r)
+	} else if b-t >= int64(len(r.slots)) {
+		r = r.grow(t, b)
+		d.ring.Store(r)
+	}
+	r.slot(b).Store(&v)
+	d.bottom.Store(b + 1)
+}
+
+// Pop removes and returns the value at the bottom of d, the one most
+// recently pushed. If d is empty, or the last value was stolen concurrently,
+// Pop returns the zero value and false. It must only be called by the owner.
+func (d *WorkStealingDeque[E]) Pop() (E, bool) {
+	var zero E
+	b := d.bottom.Load() - 1
+	r := d.ring.Load()
+	d.bottom.Store(b)
+	t := d.top.Load()
+	if t > b {
+		d.bottom.Store(b + 1)
+		return zero, false
+	}
+	s := r.slot(b)
+	p := s.Load()
+	if t == b {
+		// Last value: race any thieves for it by claiming the top.
+		won := d.top.CompareAndSwap(t, t+1)
+		d.bottom.Store(b + 1)
+		if !won {
+			return zero, false
+		}
+	}
+	s.CompareAndSwap(p, nil) // avoid memory leaks
+	return *p, true
+}
+
+// Steal removes and returns the value at the top of d, the oldest one.
+// It returns the zero value and false if d is empty or if it lost a race
+// with the owner or another thief, in which case the caller may retry.
+// Steal is safe for concurrent use.
+func (d *WorkStealingDeque[E]) Steal() (E, bool) {
+	var zero E
+	t := d.top.Load()
+	b := d.bottom.Load()
+	if t >= b {
+		return zero, false
+	}
+	s := d.ring.Load().slot(t)
+	p := s.Load()
+	if !d.top.CompareAndSwap(t, t+1) {
+		return zero, false
+	}
+	s.CompareAndSwap(p, nil) // avoid memory leaks
+	return *p, true
+}
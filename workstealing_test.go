@@ -0,0 +1,94 @@
+package list
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestWorkStealingDeque(t *testing.T) {
+	var d WorkStealingDeque[int]
+	if _, ok := d.Pop(); ok {
+		t.Fatalf("Pop on empty deque succeeded")
+	}
+	if _, ok := d.Steal(); ok {
+		t.Fatalf("Steal on empty deque succeeded")
+	}
+	for i := 0; i < 100; i++ {
+		d.Push(i)
+	}
+	if n := d.Len(); n != 100 {
+		t.Errorf("d.Len() = %d, want 100", n)
+	}
+	if v, ok := d.Steal(); !ok || v != 0 {
+		t.Errorf("d.Steal() = %d, %v; want 0, true", v, ok)
+	}
+	if v, ok := d.Pop(); !ok || v != 99 {
+		t.Errorf("d.Pop() = %d, %v; want 99, true", v, ok)
+	}
+	for i := 98; i >= 1; i-- {
+		if v, ok := d.Pop(); !ok || v != i {
+			t.Fatalf("d.Pop() = %d, %v; want %d, true", v, ok, i)
+		}
+	}
+	if _, ok := d.Pop(); ok {
+		t.Errorf("Pop on drained deque succeeded")
+	}
+}
+
+func TestWorkStealingDequeConcurrent(t *testing.T) {
+	const n, thieves = 20000, 4
+	var d WorkStealingDeque[int]
+	seen := make([]atomic.Int32, n)
+	var taken atomic.Int64
+	done := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < thieves; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				if v, ok := d.Steal(); ok {
+					seen[v].Add(1)
+					taken.Add(1)
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		d.Push(i)
+		if i%3 == 0 {
+			if v, ok := d.Pop(); ok {
+				seen[v].Add(1)
+				taken.Add(1)
+			}
+		}
+	}
+	for {
+		v, ok := d.Pop()
+		if !ok {
+			break
+		}
+		seen[v].Add(1)
+		taken.Add(1)
+	}
+	// Thieves finish any steal in progress before observing done.
+	close(done)
+	wg.Wait()
+
+	if got := taken.Load(); got != n {
+		t.Errorf("took %d values, want %d", got, n)
+	}
+	for i := range seen {
+		if c := seen[i].Load(); c != 1 {
+			t.Fatalf("value %d taken %d times", i, c)
+		}
+	}
+}